@@ -23,6 +23,12 @@ type Client interface {
 	// SetInfoLogger when set will output which requests and params are used to
 	// the logger
 	SetInfoLogger(logger Logger) Client
+	// SetRetryPolicy changes how many times and how long to wait before
+	// retrying requests that failed by transient errors
+	SetRetryPolicy(p RetryPolicy) Client
+	// WithContext returns a copy of the Client that will send its requests
+	// using ctx, so they can be cancelled or have a deadline
+	WithContext(ctx context.Context) Client
 
 	GetWorkspace(GetWorkspace) (dto.Workspace, error)
 	GetWorkspaces(GetWorkspaces) ([]dto.Workspace, error)
@@ -33,6 +39,12 @@ type Client interface {
 
 	AddClient(AddClientParam) (dto.Client, error)
 	GetClients(GetClientsParam) ([]dto.Client, error)
+	// GetClient get a single client, if exists
+	GetClient(GetClientParam) (dto.Client, error)
+	// UpdateClient changes name, note, address or archived status of a client
+	UpdateClient(UpdateClientParam) (dto.Client, error)
+	// DeleteClient removes a client forever
+	DeleteClient(DeleteClientParam) (dto.Client, error)
 
 	// GetProjects get all project of a workspace
 	GetProjects(GetProjectsParam) ([]dto.Project, error)
@@ -67,6 +79,12 @@ type Client interface {
 
 	GetTag(GetTagParam) (*dto.Tag, error)
 	GetTags(GetTagsParam) ([]dto.Tag, error)
+	// AddTag creates a new tag on the workspace
+	AddTag(AddTagParam) (dto.Tag, error)
+	// UpdateTag changes the name or archived status of a tag
+	UpdateTag(UpdateTagParam) (dto.Tag, error)
+	// DeleteTag removes a tag forever
+	DeleteTag(DeleteTagParam) (dto.Tag, error)
 
 	ChangeInvoiced(ChangeInvoicedParam) error
 	CreateTimeEntry(CreateTimeEntryParam) (dto.TimeEntryImpl, error)
@@ -86,17 +104,27 @@ type Client interface {
 type client struct {
 	baseURL *url.URL
 	http.Client
-	debugLogger    Logger
-	infoLogger     Logger
-	requestTickets chan struct{}
+	debugLogger Logger
+	infoLogger  Logger
+	limiter     *RateLimiter
+	retryPolicy RetryPolicy
+	ctx         context.Context
+}
+
+// ClientOption changes optional behaviors of the Client
+type ClientOption func(*client)
+
+// WithRateLimiter sets which RateLimiter the Client will use, instead of the
+// one shared by the API key
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(c *client) {
+		c.limiter = l
+	}
 }
 
 // BASE_URL is the Clockify API base URL
 const BASE_URL = "https://api.clockify.me/api"
 
-// REQUEST_RATE_LIMIT maximum number of requests per second
-const REQUEST_RATE_LIMIT = 50
-
 // ErrorMissingAPIKey returned if X-Api-Key is missing
 var ErrorMissingAPIKey = errors.New("api Key must be informed")
 
@@ -106,6 +134,7 @@ var ErrorMissingAPIURL = errors.New("api URL must be informed")
 func NewClientFromUrlAndKey(
 	apiKey,
 	urlString string,
+	opts ...ClientOption,
 ) (Client, error) {
 	if apiKey == "" {
 		return nil, errors.WithStack(ErrorMissingAPIKey)
@@ -120,7 +149,8 @@ func NewClientFromUrlAndKey(
 		return nil, errors.WithStack(err)
 	}
 
-	return &client{
+	c := &client{
+		ctx:     context.Background(),
 		baseURL: u,
 		Client: http.Client{
 			Transport: transport{
@@ -128,51 +158,28 @@ func NewClientFromUrlAndKey(
 				next:   http.DefaultTransport,
 			},
 		},
-		requestTickets: startRequestTick(REQUEST_RATE_LIMIT),
-	}, nil
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	if c.limiter == nil {
+		c.limiter = RateLimiterFor(apiKey)
+	}
+
+	return c, nil
 }
 
 // NewClient create a new Client, based on: https://clockify.github.io/clockify_api_docs/
-func NewClient(apiKey string) (Client, error) {
+func NewClient(apiKey string, opts ...ClientOption) (Client, error) {
 	return NewClientFromUrlAndKey(
 		apiKey,
 		BASE_URL,
+		opts...,
 	)
 }
 
-func startRequestTick(limit int) chan struct{} {
-	ch := make(chan struct{}, limit)
-
-	running := true
-	release := func() {
-		i := len(ch)
-		for i < limit {
-			if !running {
-				return
-			}
-
-			i = i + 1
-			ch <- struct{}{}
-		}
-	}
-
-	go func() {
-		release()
-		for {
-			select {
-			case <-time.After(time.Second):
-				go release()
-			case <-context.Background().Done():
-				running = false
-				defer close(ch)
-				return
-			}
-		}
-	}()
-
-	return ch
-}
-
 // GetWorkspaces will be used to filter the workspaces
 type GetWorkspaces struct {
 	Name string
@@ -219,6 +226,8 @@ const (
 	timeEntryIDField    = field("time entry id")
 	nameField           = field("name")
 	taskIDField         = field("task id")
+	tagIDField          = field("tag id")
+	clientIDField       = field("client id")
 	estimateMethodField = field("estimate method")
 	estimateTypeField   = field("estimate type")
 	resetOptionField    = field("reset option")
@@ -1118,6 +1127,8 @@ type CreateTimeEntryParam struct {
 	ProjectID   string
 	TaskID      string
 	TagIDs      []string
+
+	CustomFields []dto.CustomFieldValue
 }
 
 // CreateTimeEntry create a new time entry
@@ -1141,13 +1152,14 @@ func (c *client) CreateTimeEntry(p CreateTimeEntryParam) (
 			p.Workspace,
 		),
 		dto.CreateTimeEntryRequest{
-			Start:       dto.DateTime{Time: p.Start},
-			End:         end,
-			Billable:    p.Billable,
-			Description: p.Description,
-			ProjectID:   p.ProjectID,
-			TaskID:      p.TaskID,
-			TagIDs:      p.TagIDs,
+			Start:        dto.DateTime{Time: p.Start},
+			End:          end,
+			Billable:     p.Billable,
+			Description:  p.Description,
+			ProjectID:    p.ProjectID,
+			TaskID:       p.TaskID,
+			TagIDs:       p.TagIDs,
+			CustomFields: p.CustomFields,
 		},
 	)
 
@@ -1192,6 +1204,138 @@ func (c *client) GetTags(p GetTagsParam) (ps []dto.Tag, err error) {
 	return ps, err
 }
 
+// AddTagParam params to create a new tag
+type AddTagParam struct {
+	Workspace string
+	Name      string
+}
+
+// AddTag creates a new tag on the workspace
+func (c *client) AddTag(p AddTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "add tag")
+
+	if err = required(map[field]string{
+		nameField:      p.Name,
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"v1/workspaces/%s/tags",
+			p.Workspace,
+		),
+		dto.AddTagRequest{
+			Name: p.Name,
+		},
+	)
+
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "AddTag")
+	return tag, err
+}
+
+// UpdateTagParam params to change a tag, Clockify requires the name to be
+// always informed, even when only archiving it
+type UpdateTagParam struct {
+	Workspace string
+	TagID     string
+	Name      string
+	Archived  *bool
+}
+
+// UpdateTag changes the name or archived status of a tag
+func (c *client) UpdateTag(p UpdateTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "update tag \"%s\"", p.TagID)
+
+	if err = required(map[field]string{
+		nameField:      p.Name,
+		workspaceField: p.Workspace,
+		tagIDField:     p.TagID,
+	}); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+		tagIDField:     p.TagID,
+	}); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		"PUT",
+		fmt.Sprintf(
+			"v1/workspaces/%s/tags/%s",
+			p.Workspace,
+			p.TagID,
+		),
+		dto.UpdateTagRequest{
+			Name:     p.Name,
+			Archived: p.Archived,
+		},
+	)
+
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "UpdateTag")
+	return tag, err
+}
+
+// DeleteTagParam identifies which tag to delete
+type DeleteTagParam struct {
+	Workspace string
+	TagID     string
+}
+
+// DeleteTag removes a tag forever
+func (c *client) DeleteTag(p DeleteTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "delete tag \"%s\"", p.TagID)
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		tagIDField:     p.TagID,
+	}
+
+	if err = required(ids); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		"DELETE",
+		fmt.Sprintf(
+			"v1/workspaces/%s/tags/%s",
+			p.Workspace,
+			p.TagID,
+		),
+		nil,
+	)
+
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "DeleteTag")
+	return tag, err
+}
+
 // GetClientsParam params to get all clients of a workspace
 type GetClientsParam struct {
 	Workspace string
@@ -1270,6 +1414,146 @@ func (c *client) AddClient(p AddClientParam) (client dto.Client, err error) {
 	return client, err
 }
 
+// GetClientParam params to get a client
+type GetClientParam struct {
+	Workspace string
+	ClientID  string
+}
+
+// GetClient get a single client, if exists
+func (c *client) GetClient(p GetClientParam) (client dto.Client, err error) {
+	defer wrapError(&err, "get client \"%s\"", p.ClientID)
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	req, err := c.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"v1/workspaces/%s/clients/%s",
+			p.Workspace,
+			p.ClientID,
+		),
+		nil,
+	)
+
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "GetClient")
+	return client, err
+}
+
+// UpdateClientParam sets the properties to change on a client, leave the
+// property as nil or "empty" to not change it
+type UpdateClientParam struct {
+	Workspace string
+	ClientID  string
+	Name      string
+	Note      *string
+	Address   *string
+	Archived  *bool
+}
+
+// UpdateClient changes name, note, address or archived status of a client
+func (c *client) UpdateClient(p UpdateClientParam) (
+	client dto.Client, err error) {
+	defer wrapError(&err, "update client \"%s\"", p.ClientID)
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	var name *string
+	if p.Name != "" {
+		name = &p.Name
+	}
+
+	req, err := c.NewRequest(
+		"PUT",
+		fmt.Sprintf(
+			"v1/workspaces/%s/clients/%s",
+			p.Workspace,
+			p.ClientID,
+		),
+		dto.UpdateClientRequest{
+			Name:     name,
+			Note:     p.Note,
+			Address:  p.Address,
+			Archived: p.Archived,
+		},
+	)
+
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "UpdateClient")
+	return client, err
+}
+
+// DeleteClientParam identifies which client to delete
+type DeleteClientParam struct {
+	Workspace string
+	ClientID  string
+}
+
+// DeleteClient removes a client forever
+func (c *client) DeleteClient(p DeleteClientParam) (
+	client dto.Client, err error) {
+	defer wrapError(&err, "delete client \"%s\"", p.ClientID)
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	req, err := c.NewRequest(
+		"DELETE",
+		fmt.Sprintf(
+			"v1/workspaces/%s/clients/%s",
+			p.Workspace,
+			p.ClientID,
+		),
+		nil,
+	)
+
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "DeleteClient")
+	return client, err
+}
+
 // GetProjectsParam params to get all project of a workspace
 type GetProjectsParam struct {
 	Workspace string
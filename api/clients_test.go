@@ -0,0 +1,174 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetClient(t *testing.T) {
+	errPrefix := `get client "` + exampleID + `": `
+	uri := "/v1/workspaces/" + exampleID + "/clients/" + exampleID
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetClientParam{ClientID: exampleID},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires client",
+			param: api.GetClientParam{Workspace: exampleID},
+			err:   `get client "": client id is required`,
+		},
+		&simpleTestCase{
+			name: "valid client",
+			param: api.GetClientParam{
+				Workspace: exampleID,
+				ClientID:  "c",
+			},
+			err: `get client "c": client id .* is not valid ID`,
+		},
+		&simpleTestCase{
+			name: "found",
+			param: api.GetClientParam{
+				Workspace: exampleID,
+				ClientID:  exampleID,
+			},
+
+			requestMethod: "get",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody: `{"id":"` + exampleID + `","name":"Client",` +
+				`"address":"Somewhere","note":"a note"}`,
+
+			result: dto.Client{
+				ID:      exampleID,
+				Name:    "Client",
+				Address: "Somewhere",
+				Note:    "a note",
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetClient(p.(api.GetClientParam))
+			})
+	}
+}
+
+func TestUpdateClient(t *testing.T) {
+	errPrefix := `update client "` + exampleID + `": `
+	uri := "/v1/workspaces/" + exampleID + "/clients/" + exampleID
+	b := true
+	note := ""
+	address := "Somewhere St, 42"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.UpdateClientParam{ClientID: exampleID},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires client",
+			param: api.UpdateClientParam{Workspace: exampleID},
+			err:   `update client "": client id is required`,
+		},
+		&simpleTestCase{
+			name: "only set fields are sent",
+			param: api.UpdateClientParam{
+				Workspace: exampleID,
+				ClientID:  exampleID,
+				Name:      "New",
+				Note:      &note,
+				Address:   &address,
+				Archived:  &b,
+			},
+
+			requestMethod: "put",
+			requestUrl:    uri,
+			requestBody: `{"name":"New","note":"",` +
+				`"address":"Somewhere St, 42","archived":true}`,
+
+			responseStatus: 200,
+			responseBody: `{"id":"` + exampleID + `","name":"New",` +
+				`"address":"Somewhere St, 42","archived":true}`,
+
+			result: dto.Client{
+				ID:       exampleID,
+				Name:     "New",
+				Address:  "Somewhere St, 42",
+				Archived: true,
+			},
+		},
+		&simpleTestCase{
+			name: "error response",
+			param: api.UpdateClientParam{
+				Workspace: exampleID,
+				ClientID:  exampleID,
+				Archived:  &b,
+			},
+
+			requestMethod: "put",
+			requestUrl:    uri,
+			requestBody:   `{"archived":true}`,
+
+			responseStatus: 400,
+			responseBody:   `{"code": 10, "message":"error"}`,
+
+			err: errPrefix + `error \(code: 10\)`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateClient(p.(api.UpdateClientParam))
+			})
+	}
+}
+
+func TestDeleteClient(t *testing.T) {
+	errPrefix := `delete client "` + exampleID + `": `
+	uri := "/v1/workspaces/" + exampleID + "/clients/" + exampleID
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.DeleteClientParam{ClientID: exampleID},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires client",
+			param: api.DeleteClientParam{Workspace: exampleID},
+			err:   `delete client "": client id is required`,
+		},
+		&simpleTestCase{
+			name: "deleted",
+			param: api.DeleteClientParam{
+				Workspace: exampleID,
+				ClientID:  exampleID,
+			},
+
+			requestMethod: "delete",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"` + exampleID + `","name":"Client"}`,
+
+			result: dto.Client{ID: exampleID, Name: "Client"},
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.DeleteClient(p.(api.DeleteClientParam))
+			})
+	}
+}
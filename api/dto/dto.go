@@ -23,6 +23,7 @@ type Workspace struct {
 	ImageURL    string            `json:"imageUrl"`
 	Settings    WorkspaceSettings `json:"workspaceSettings"`
 	HourlyRate  Rate              `json:"hourlyRate"`
+	CostRate    *Rate             `json:"costRate"`
 	Memberships []Membership
 }
 
@@ -109,6 +110,7 @@ type TimeEntry struct {
 	Description   string        `json:"description"`
 	HourlyRate    Rate          `json:"hourlyRate"`
 	IsLocked      bool          `json:"isLocked"`
+	IsInvoiced    bool          `json:"isInvoiced"`
 	Project       *Project      `json:"project"`
 	CustomFields  []CustomField `json:"customFieldValues"`
 	ProjectID     string        `json:"projectId"`
@@ -154,6 +156,7 @@ type Tag struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	WorkspaceID string `json:"workspaceId"`
+	Archived    bool   `json:"archived"`
 }
 
 func (e Tag) GetID() string   { return e.ID }
@@ -194,6 +197,8 @@ type Client struct {
 	Name        string `json:"name"`
 	WorkspaceID string `json:"workspaceId"`
 	Archived    bool   `json:"archived"`
+	Note        string `json:"note"`
+	Address     string `json:"address"`
 }
 
 func (e Client) GetID() string   { return e.ID }
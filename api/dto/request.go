@@ -310,6 +310,14 @@ type AddClientRequest struct {
 	Name string `json:"name"`
 }
 
+// UpdateClientRequest represents the parameters to update a client
+type UpdateClientRequest struct {
+	Name     *string `json:"name,omitempty"`
+	Note     *string `json:"note,omitempty"`
+	Address  *string `json:"address,omitempty"`
+	Archived *bool   `json:"archived,omitempty"`
+}
+
 type GetProjectsRequest struct {
 	Name     string
 	Archived *bool
@@ -447,6 +455,17 @@ func (r GetTagsRequest) AppendToQuery(u *url.URL) *url.URL {
 	return u
 }
 
+// AddTagRequest represents the parameters to create a tag
+type AddTagRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateTagRequest represents the parameters to update a tag
+type UpdateTagRequest struct {
+	Name     string `json:"name"`
+	Archived *bool  `json:"archived,omitempty"`
+}
+
 // GetTasksRequest represents the query filters to search tasks of a project
 type GetTasksRequest struct {
 	Name   string
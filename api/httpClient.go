@@ -2,10 +2,14 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/pkg/errors"
@@ -61,7 +65,7 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 		c.infof("request body: %s", buf.(*bytes.Buffer))
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(c.ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -74,13 +78,182 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 	return req, nil
 }
 
+// RetryPolicy sets how requests that failed by transient errors are retried
+type RetryPolicy struct {
+	// Retries is how many times a request can be retried, zero disables it
+	Retries int
+	// BaseDelay is the wait before the first retry, it doubles on each retry
+	BaseDelay time.Duration
+	// MaxDelay is the ceiling for the wait between attempts, including the
+	// ones asked by the API using Retry-After
+	MaxDelay time.Duration
+}
+
+const (
+	// DEFAULT_RETRY_BASE_DELAY is used when RetryPolicy.BaseDelay is not set
+	DEFAULT_RETRY_BASE_DELAY = 500 * time.Millisecond
+	// DEFAULT_RETRY_MAX_DELAY is used when RetryPolicy.MaxDelay is not set
+	DEFAULT_RETRY_MAX_DELAY = 30 * time.Second
+)
+
+// WithContext returns a copy of the client that sends its requests using ctx
+func (c *client) WithContext(ctx context.Context) Client {
+	n := *c
+	n.ctx = ctx
+	return &n
+}
+
+// SetRetryPolicy changes how failed requests are retried
+func (c *client) SetRetryPolicy(p RetryPolicy) Client {
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DEFAULT_RETRY_BASE_DELAY
+	}
+
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DEFAULT_RETRY_MAX_DELAY
+	}
+
+	c.retryPolicy = p
+	return c
+}
+
+// isIdempotent returns if a request with this method can be sent again
+// without changing the result
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions,
+		http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry checks if the failure is transient and the request can be
+// sent again. Too many requests are always retried, because the API did
+// not process them.
+func (p RetryPolicy) shouldRetry(
+	req *http.Request, r *http.Response, err error, retries int) bool {
+	if retries >= p.Retries {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method) &&
+			!errors.Is(err, context.Canceled) &&
+			!errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch r.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// retryAfter reads the Retry-After header, as seconds or as a date
+func retryAfter(r *http.Response) (time.Duration, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	v := r.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// delay is how long to wait before the next attempt, growing exponentially
+// with jitter, unless the API asked for a specific time
+func (p RetryPolicy) delay(retries int, r *http.Response) time.Duration {
+	if d, ok := retryAfter(r); ok {
+		return min(d, p.MaxDelay)
+	}
+
+	d := p.MaxDelay
+	if retries < 32 && p.BaseDelay<<retries < p.MaxDelay {
+		d = p.BaseDelay << retries
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// rewind prepares the request to be sent again
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
 // Do executes a http.Request inside the Clockify's Client
 func (c *client) Do(
 	req *http.Request, v interface{}, name string) (r *http.Response, err error) {
 
-	<-c.requestTickets
+	retries := 0
+	for {
+		if err = c.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		r, err = c.Client.Do(req)
+		if !c.retryPolicy.shouldRetry(req, r, err, retries) {
+			break
+		}
+
+		reason := "error: " + errorMessage(err)
+		if err == nil {
+			reason = "status: " + strconv.Itoa(r.StatusCode)
+		}
+
+		wait := c.retryPolicy.delay(retries, r)
+		if r != nil {
+			_, _ = io.Copy(io.Discard, r.Body)
+			_ = r.Body.Close()
+		}
+
+		retries++
+		c.infof("name: %s, method: %s, url: %s, %s, retry %d of %d in %s",
+			name, req.Method, req.URL.String(), reason,
+			retries, c.retryPolicy.Retries, wait)
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, errors.WithStack(req.Context().Err())
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
 
-	r, err = c.Client.Do(req)
 	if err != nil {
 		return r, err
 	}
@@ -98,11 +271,12 @@ func (c *client) Do(
 	}
 
 	if c.debugLogger != nil {
-		c.debugf("name: %s, method: %s, url: %s, status: %d, response: \"%s\"",
-			name, req.Method, req.URL.String(), r.StatusCode, buf)
+		c.debugf("name: %s, method: %s, url: %s, status: %d, retries: %d, "+
+			"response: \"%s\"",
+			name, req.Method, req.URL.String(), r.StatusCode, retries, buf)
 	} else {
-		c.infof("name: %s, method: %s, url: %s, status: %d",
-			name, req.Method, req.URL.String(), r.StatusCode)
+		c.infof("name: %s, method: %s, url: %s, status: %d, retries: %d",
+			name, req.Method, req.URL.String(), r.StatusCode, retries)
 	}
 
 	decoder := json.NewDecoder(buf)
@@ -143,3 +317,11 @@ func (c *client) Do(
 
 	return r, errors.WithStack(decoder.Decode(v))
 }
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
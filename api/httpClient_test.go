@@ -0,0 +1,184 @@
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type logger struct {
+	lines []string
+}
+
+func (l *logger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, format)
+}
+
+func (l *logger) Print(v ...interface{}) {}
+
+func (l *logger) Println(v ...interface{}) {}
+
+func retryServer(t *testing.T, responses ...func(http.ResponseWriter)) (
+	*httptest.Server, *[]string) {
+	bodies := make([]string, 0, len(responses))
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+
+			if len(bodies) > len(responses) {
+				t.Error("too many calls to the api")
+				w.WriteHeader(500)
+				return
+			}
+
+			responses[len(bodies)-1](w)
+		}))
+	t.Cleanup(s.Close)
+
+	return s, &bodies
+}
+
+func status(code int, body string) func(http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func newRetryClient(t *testing.T, url string, retries int) api.Client {
+	c, err := api.NewClientFromUrlAndKey("a-key", url)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return c.SetRetryPolicy(api.RetryPolicy{
+		Retries:   retries,
+		BaseDelay: time.Millisecond,
+		MaxDelay:  20 * time.Millisecond,
+	})
+}
+
+func TestRetryIdempotentRequests(t *testing.T) {
+	s, bodies := retryServer(t,
+		status(503, `{"code":503,"message":"unavailable"}`),
+		status(502, ""),
+		status(200, `[{"id":"w1","name":"workspace"}]`),
+	)
+
+	l := &logger{}
+	c := newRetryClient(t, s.URL, 3)
+	c.SetInfoLogger(l)
+
+	ws, err := c.GetWorkspaces(api.GetWorkspaces{})
+	assert.NoError(t, err)
+	if assert.Len(t, ws, 1) {
+		assert.Equal(t, "workspace", ws[0].Name)
+	}
+	assert.Len(t, *bodies, 3)
+
+	if assert.Len(t, l.lines, 3) {
+		assert.Contains(t, l.lines[0], "retry %d of %d")
+		assert.Contains(t, l.lines[2], "retries: %d")
+	}
+}
+
+func TestRetryGivesUpAfterAttempts(t *testing.T) {
+	s, bodies := retryServer(t,
+		status(500, `{"code":500,"message":"failed"}`),
+		status(500, `{"code":500,"message":"failed"}`),
+	)
+
+	c := newRetryClient(t, s.URL, 1)
+
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	assert.Error(t, err)
+	assert.Len(t, *bodies, 2)
+}
+
+func TestRetryDoesNotRepeatPosts(t *testing.T) {
+	s, bodies := retryServer(t,
+		status(503, `{"code":503,"message":"unavailable"}`),
+	)
+
+	c := newRetryClient(t, s.URL, 3)
+
+	_, err := c.AddTag(api.AddTagParam{Workspace: exampleID, Name: "tag"})
+	assert.Error(t, err)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTooManyRequestsHonorsRetryAfter(t *testing.T) {
+	s, bodies := retryServer(t,
+		func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(429)
+		},
+		status(201, `{"id":"t1","name":"tag"}`),
+	)
+
+	c := newRetryClient(t, s.URL, 3)
+
+	start := time.Now()
+	tag, err := c.AddTag(api.AddTagParam{Workspace: exampleID, Name: "tag"})
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", tag.ID)
+
+	// the wait is capped by the max delay
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+
+	if assert.Len(t, *bodies, 2) {
+		assert.Equal(t, (*bodies)[0], (*bodies)[1])
+		assert.True(t, strings.Contains((*bodies)[1], `"name":"tag"`))
+	}
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	s, bodies := retryServer(t,
+		status(503, `{"code":503,"message":"unavailable"}`),
+	)
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	assert.Error(t, err)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestWithContextCancelsRequests(t *testing.T) {
+	s, bodies := retryServer(t)
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.WithContext(ctx).GetWorkspaces(api.GetWorkspaces{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, *bodies, 0)
+}
+
+func TestWithContextStopsRetries(t *testing.T) {
+	s, bodies := retryServer(t,
+		status(503, `{"code":503,"message":"unavailable"}`),
+	)
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetRetryPolicy(api.RetryPolicy{Retries: 3, BaseDelay: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.WithContext(ctx).GetWorkspaces(api.GetWorkspaces{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, *bodies, 1)
+}
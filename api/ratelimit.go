@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// REQUEST_RATE_LIMIT maximum number of requests per second
+const REQUEST_RATE_LIMIT = 50
+
+// ErrorRateLimiterStopped is returned when waiting on a stopped RateLimiter
+var ErrorRateLimiterStopped = errors.New("rate limiter was stopped")
+
+// Clock tells the current time and waits for a duration, the RateLimiter
+// uses it so tests can control the time
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// RateLimiter is a token bucket that allows a number of requests per second,
+// it can be shared between clients and is safe for concurrent use
+type RateLimiter struct {
+	limit float64
+	burst float64
+	clock Clock
+
+	m      sync.Mutex
+	tokens float64
+	last   time.Time
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// RateLimiterOption changes optional behaviors of the RateLimiter
+type RateLimiterOption func(*RateLimiter)
+
+// WithBurst sets how many requests can be made at once when the bucket is
+// full, by default it is the same as the limit
+func WithBurst(burst int) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.burst = float64(burst)
+	}
+}
+
+// WithClock sets which Clock will be used to refill the bucket
+func WithClock(c Clock) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.clock = c
+	}
+}
+
+// NewRateLimiter creates a full RateLimiter allowing limit requests per
+// second
+func NewRateLimiter(limit int, opts ...RateLimiterOption) *RateLimiter {
+	l := &RateLimiter{
+		limit: float64(limit),
+		burst: float64(limit),
+		clock: realClock{},
+		done:  make(chan struct{}),
+	}
+
+	for _, o := range opts {
+		o(l)
+	}
+
+	if l.limit <= 0 {
+		l.limit = 1
+	}
+
+	if l.burst < 1 {
+		l.burst = 1
+	}
+
+	l.tokens = l.burst
+	l.last = l.clock.Now()
+	return l
+}
+
+// refill adds the tokens earned since the last refill, must be called
+// holding the lock
+func (l *RateLimiter) refill() {
+	now := l.clock.Now()
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * l.limit
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
+
+// Wait blocks until a request can be made, the context is done or the
+// RateLimiter is stopped. Callers are served in the order they arrive.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-l.done:
+		return errors.WithStack(ErrorRateLimiterStopped)
+	default:
+	}
+
+	if err := ctx.Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	l.m.Lock()
+	l.refill()
+	// the token is reserved even if it is not available yet, so the next
+	// callers will wait after this one
+	l.tokens--
+	tokens := l.tokens
+	l.m.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+
+	wait := time.Duration(-tokens / l.limit * float64(time.Second))
+	select {
+	case <-l.clock.After(wait):
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return errors.WithStack(ctx.Err())
+	case <-l.done:
+		return errors.WithStack(ErrorRateLimiterStopped)
+	}
+}
+
+// cancel gives back a reserved token that will not be used
+func (l *RateLimiter) cancel() {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.refill()
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Stop releases all callers waiting on the RateLimiter, and makes further
+// calls to Wait fail
+func (l *RateLimiter) Stop() {
+	l.stopOnce.Do(func() {
+		close(l.done)
+	})
+}
+
+var (
+	limitersM sync.Mutex
+	limiters  = map[string]*RateLimiter{}
+)
+
+// RateLimiterFor returns the RateLimiter shared by all clients using the API
+// key, as the API limits the requests by key. If none was set, one with
+// REQUEST_RATE_LIMIT is created.
+func RateLimiterFor(apiKey string) *RateLimiter {
+	limitersM.Lock()
+	defer limitersM.Unlock()
+
+	l, ok := limiters[apiKey]
+	if !ok {
+		l = NewRateLimiter(REQUEST_RATE_LIMIT)
+		limiters[apiKey] = l
+	}
+
+	return l
+}
+
+// SetRateLimiterFor changes which RateLimiter will be used by clients
+// created after it for the API key, the previous one is not stopped
+func SetRateLimiterFor(apiKey string, l *RateLimiter) {
+	limitersM.Lock()
+	defer limitersM.Unlock()
+
+	limiters[apiKey] = l
+}
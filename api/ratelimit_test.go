@@ -0,0 +1,160 @@
+package api_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+type fakeClock struct {
+	m       sync.Mutex
+	now     time.Time
+	waiters []waiter
+
+	waiting chan struct{}
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:     time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		waiting: make(chan struct{}, 10),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, waiter{at: c.now.Add(d), ch: ch})
+	c.waiting <- struct{}{}
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.now = c.now.Add(d)
+	ws := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			ws = append(ws, w)
+			continue
+		}
+
+		w.ch <- c.now
+	}
+	c.waiters = ws
+}
+
+func waitAsync(l *api.RateLimiter, ctx context.Context) chan error {
+	ch := make(chan error, 1)
+	go func() { ch <- l.Wait(ctx) }()
+	return ch
+}
+
+func TestRateLimiterWaitsForTokens(t *testing.T) {
+	c := newFakeClock()
+	l := api.NewRateLimiter(2, api.WithClock(c))
+	ctx := context.Background()
+
+	assert.NoError(t, l.Wait(ctx))
+	assert.NoError(t, l.Wait(ctx))
+
+	ch := waitAsync(l, ctx)
+	<-c.waiting
+
+	c.Advance(250 * time.Millisecond)
+	select {
+	case <-ch:
+		t.Fatal("should wait for the bucket to refill")
+	default:
+	}
+
+	c.Advance(250 * time.Millisecond)
+	assert.NoError(t, <-ch)
+
+	// one second refills the bucket, but not beyond the burst
+	c.Advance(time.Minute)
+	assert.NoError(t, l.Wait(ctx))
+	assert.NoError(t, l.Wait(ctx))
+
+	ch = waitAsync(l, ctx)
+	<-c.waiting
+	c.Advance(500 * time.Millisecond)
+	assert.NoError(t, <-ch)
+}
+
+func TestRateLimiterCancelGivesTokenBack(t *testing.T) {
+	c := newFakeClock()
+	l := api.NewRateLimiter(2, api.WithClock(c), api.WithBurst(1))
+
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := waitAsync(l, ctx)
+	<-c.waiting
+	cancel()
+	assert.ErrorIs(t, <-ch, context.Canceled)
+
+	c.Advance(500 * time.Millisecond)
+	assert.NoError(t, l.Wait(context.Background()))
+}
+
+func TestRateLimiterStop(t *testing.T) {
+	c := newFakeClock()
+	l := api.NewRateLimiter(1, api.WithClock(c))
+	ctx := context.Background()
+
+	assert.NoError(t, l.Wait(ctx))
+
+	ch := waitAsync(l, ctx)
+	<-c.waiting
+
+	l.Stop()
+	assert.ErrorIs(t, <-ch, api.ErrorRateLimiterStopped)
+	assert.ErrorIs(t, l.Wait(ctx), api.ErrorRateLimiterStopped)
+
+	// stopping again is harmless
+	l.Stop()
+}
+
+func TestRateLimiterIsSharedByAPIKey(t *testing.T) {
+	a := api.RateLimiterFor("key-a")
+	assert.Same(t, a, api.RateLimiterFor("key-a"))
+	assert.NotSame(t, a, api.RateLimiterFor("key-b"))
+
+	l := api.NewRateLimiter(10)
+	api.SetRateLimiterFor("key-c", l)
+	assert.Same(t, l, api.RateLimiterFor("key-c"))
+}
+
+func TestClientUsesRateLimiter(t *testing.T) {
+	s, bodies := retryServer(t)
+
+	l := api.NewRateLimiter(1)
+	l.Stop()
+
+	c, err := api.NewClientFromUrlAndKey("a-key", s.URL, api.WithRateLimiter(l))
+	assert.NoError(t, err)
+
+	_, err = c.GetWorkspaces(api.GetWorkspaces{})
+	assert.ErrorIs(t, err, api.ErrorRateLimiterStopped)
+	assert.Len(t, *bodies, 0)
+}
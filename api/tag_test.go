@@ -118,3 +118,197 @@ func TestGetTags(t *testing.T) {
 	}
 
 }
+
+func TestAddTag(t *testing.T) {
+	errPrefix := `add tag: `
+	uri := "/v1/workspaces/" + exampleID + "/tags"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.AddTagParam{Name: "tag"},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires name",
+			param: api.AddTagParam{Workspace: exampleID},
+			err:   errPrefix + "name is required",
+		},
+		&simpleTestCase{
+			name:  "valid workspace",
+			param: api.AddTagParam{Workspace: "w", Name: "tag"},
+			err:   errPrefix + "workspace .* is not valid ID",
+		},
+		&simpleTestCase{
+			name: "tag already exists",
+			param: api.AddTagParam{
+				Workspace: exampleID,
+				Name:      "Meeting",
+			},
+
+			requestMethod: "post",
+			requestUrl:    uri,
+			requestBody:   `{"name":"Meeting"}`,
+
+			responseStatus: 400,
+			responseBody: `{"code": 501, ` +
+				`"message":"Tag with name 'Meeting' already exists"}`,
+
+			err: errPrefix + `Tag with name 'Meeting' already exists ` +
+				`\(code: 501\)`,
+		},
+		&simpleTestCase{
+			name: "tag created",
+			param: api.AddTagParam{
+				Workspace: exampleID,
+				Name:      "Meeting",
+			},
+
+			requestMethod: "post",
+			requestUrl:    uri,
+			requestBody:   `{"name":"Meeting"}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"t1","name":"Meeting","archived":false}`,
+
+			result: dto.Tag{ID: "t1", Name: "Meeting"},
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddTag(p.(api.AddTagParam))
+			})
+	}
+}
+
+func TestUpdateTag(t *testing.T) {
+	errPrefix := `update tag "` + exampleID + `": `
+	uri := "/v1/workspaces/" + exampleID + "/tags/" + exampleID
+	b := true
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.UpdateTagParam{TagID: exampleID, Name: "tag"},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires tag",
+			param: api.UpdateTagParam{Workspace: exampleID, Name: "tag"},
+			err:   `update tag "": tag id is required`,
+		},
+		&simpleTestCase{
+			name:  "requires name",
+			param: api.UpdateTagParam{Workspace: exampleID, TagID: exampleID},
+			err:   errPrefix + "name is required",
+		},
+		&simpleTestCase{
+			name: "valid tag",
+			param: api.UpdateTagParam{
+				Workspace: exampleID,
+				TagID:     "t",
+				Name:      "tag",
+			},
+			err: `update tag "t": tag id .* is not valid ID`,
+		},
+		&simpleTestCase{
+			name: "rename",
+			param: api.UpdateTagParam{
+				Workspace: exampleID,
+				TagID:     exampleID,
+				Name:      "New Name",
+			},
+
+			requestMethod: "put",
+			requestUrl:    uri,
+			requestBody:   `{"name":"New Name"}`,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"` + exampleID + `","name":"New Name"}`,
+
+			result: dto.Tag{ID: exampleID, Name: "New Name"},
+		},
+		&simpleTestCase{
+			name: "archive",
+			param: api.UpdateTagParam{
+				Workspace: exampleID,
+				TagID:     exampleID,
+				Name:      "Old",
+				Archived:  &b,
+			},
+
+			requestMethod: "put",
+			requestUrl:    uri,
+			requestBody:   `{"name":"Old","archived":true}`,
+
+			responseStatus: 200,
+			responseBody: `{"id":"` + exampleID + `","name":"Old",` +
+				`"archived":true}`,
+
+			result: dto.Tag{ID: exampleID, Name: "Old", Archived: true},
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateTag(p.(api.UpdateTagParam))
+			})
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	errPrefix := `delete tag "` + exampleID + `": `
+	uri := "/v1/workspaces/" + exampleID + "/tags/" + exampleID
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.DeleteTagParam{TagID: exampleID},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "requires tag",
+			param: api.DeleteTagParam{Workspace: exampleID},
+			err:   `delete tag "": tag id is required`,
+		},
+		&simpleTestCase{
+			name: "not found",
+			param: api.DeleteTagParam{
+				Workspace: exampleID,
+				TagID:     exampleID,
+			},
+
+			requestMethod: "delete",
+			requestUrl:    uri,
+
+			responseStatus: 404,
+
+			err: errPrefix + `Nothing was found \(code: 404\)`,
+		},
+		&simpleTestCase{
+			name: "deleted",
+			param: api.DeleteTagParam{
+				Workspace: exampleID,
+				TagID:     exampleID,
+			},
+
+			requestMethod: "delete",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"` + exampleID + `","name":"Tag"}`,
+
+			result: dto.Tag{ID: exampleID, Name: "Tag"},
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.DeleteTag(p.(api.DeleteTagParam))
+			})
+	}
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path"
 	"strings"
 
@@ -34,7 +36,16 @@ func main() {
 }
 
 func execute() int {
-	f := cmdutil.NewFactory(cmdutil.Version{
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	go func() {
+		// a second interrupt should kill the CLI right away
+		<-ctx.Done()
+		stop()
+	}()
+
+	f := cmdutil.NewFactory(ctx, cmdutil.Version{
 		Tag:    version,
 		Commit: commit,
 		Date:   date,
@@ -49,7 +60,7 @@ func execute() int {
 	err := bindViper(rootCmd)
 
 	if err == nil {
-		cmd, err = rootCmd.ExecuteC()
+		cmd, err = rootCmd.ExecuteContextC(ctx)
 	}
 
 	if err == nil {
@@ -57,7 +68,8 @@ func execute() int {
 	}
 
 	stderr := cmd.ErrOrStderr()
-	if errors.Is(err, terminal.InterruptErr) {
+	if errors.Is(err, terminal.InterruptErr) ||
+		errors.Is(err, context.Canceled) {
 		_, _ = fmt.Fprintln(stderr)
 		return exitCancel
 	}
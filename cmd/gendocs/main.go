@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -53,7 +54,7 @@ func execute() error {
 		return "/en/commands/" + strings.ToLower(base) + "/"
 	}
 
-	cmd := cmd.NewCmdRoot(cmdutil.NewFactory(cmdutil.Version{}))
+	cmd := cmd.NewCmdRoot(cmdutil.NewFactory(context.Background(), cmdutil.Version{}))
 
 	fmt.Println("Generating Hugo command-line documentation in", docdir, "...")
 	err := doc.GenMarkdownTreeCustom(cmd, docdir, prepender, linkHandler)
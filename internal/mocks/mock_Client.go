@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	mock "github.com/stretchr/testify/mock"
@@ -157,6 +159,66 @@ func (_c *MockClient_AddProject_Call) RunAndReturn(run func(addProjectParam api.
 	return _c
 }
 
+// AddTag provides a mock function for the type MockClient
+func (_mock *MockClient) AddTag(addTagParam api.AddTagParam) (dto.Tag, error) {
+	ret := _mock.Called(addTagParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTag")
+	}
+
+	var r0 dto.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.AddTagParam) (dto.Tag, error)); ok {
+		return returnFunc(addTagParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.AddTagParam) dto.Tag); ok {
+		r0 = returnFunc(addTagParam)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.AddTagParam) error); ok {
+		r1 = returnFunc(addTagParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type MockClient_AddTag_Call struct {
+	*mock.Call
+}
+
+// AddTag is a helper method to define mock.On call
+//   - addTagParam api.AddTagParam
+func (_e *MockClient_Expecter) AddTag(addTagParam interface{}) *MockClient_AddTag_Call {
+	return &MockClient_AddTag_Call{Call: _e.mock.On("AddTag", addTagParam)}
+}
+
+func (_c *MockClient_AddTag_Call) Run(run func(addTagParam api.AddTagParam)) *MockClient_AddTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.AddTagParam
+		if args[0] != nil {
+			arg0 = args[0].(api.AddTagParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_AddTag_Call) Return(tag dto.Tag, err error) *MockClient_AddTag_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockClient_AddTag_Call) RunAndReturn(run func(addTagParam api.AddTagParam) (dto.Tag, error)) *MockClient_AddTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddTask provides a mock function for the type MockClient
 func (_mock *MockClient) AddTask(addTaskParam api.AddTaskParam) (dto.Task, error) {
 	ret := _mock.Called(addTaskParam)
@@ -328,6 +390,66 @@ func (_c *MockClient_CreateTimeEntry_Call) RunAndReturn(run func(createTimeEntry
 	return _c
 }
 
+// DeleteClient provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteClient(deleteClientParam api.DeleteClientParam) (dto.Client, error) {
+	ret := _mock.Called(deleteClientParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClient")
+	}
+
+	var r0 dto.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.DeleteClientParam) (dto.Client, error)); ok {
+		return returnFunc(deleteClientParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.DeleteClientParam) dto.Client); ok {
+		r0 = returnFunc(deleteClientParam)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.DeleteClientParam) error); ok {
+		r1 = returnFunc(deleteClientParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_DeleteClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClient'
+type MockClient_DeleteClient_Call struct {
+	*mock.Call
+}
+
+// DeleteClient is a helper method to define mock.On call
+//   - deleteClientParam api.DeleteClientParam
+func (_e *MockClient_Expecter) DeleteClient(deleteClientParam interface{}) *MockClient_DeleteClient_Call {
+	return &MockClient_DeleteClient_Call{Call: _e.mock.On("DeleteClient", deleteClientParam)}
+}
+
+func (_c *MockClient_DeleteClient_Call) Run(run func(deleteClientParam api.DeleteClientParam)) *MockClient_DeleteClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.DeleteClientParam
+		if args[0] != nil {
+			arg0 = args[0].(api.DeleteClientParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteClient_Call) Return(client dto.Client, err error) *MockClient_DeleteClient_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *MockClient_DeleteClient_Call) RunAndReturn(run func(deleteClientParam api.DeleteClientParam) (dto.Client, error)) *MockClient_DeleteClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteProject provides a mock function for the type MockClient
 func (_mock *MockClient) DeleteProject(deleteProjectParam api.DeleteProjectParam) (dto.Project, error) {
 	ret := _mock.Called(deleteProjectParam)
@@ -388,6 +510,66 @@ func (_c *MockClient_DeleteProject_Call) RunAndReturn(run func(deleteProjectPara
 	return _c
 }
 
+// DeleteTag provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteTag(deleteTagParam api.DeleteTagParam) (dto.Tag, error) {
+	ret := _mock.Called(deleteTagParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 dto.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.DeleteTagParam) (dto.Tag, error)); ok {
+		return returnFunc(deleteTagParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.DeleteTagParam) dto.Tag); ok {
+		r0 = returnFunc(deleteTagParam)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.DeleteTagParam) error); ok {
+		r1 = returnFunc(deleteTagParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockClient_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - deleteTagParam api.DeleteTagParam
+func (_e *MockClient_Expecter) DeleteTag(deleteTagParam interface{}) *MockClient_DeleteTag_Call {
+	return &MockClient_DeleteTag_Call{Call: _e.mock.On("DeleteTag", deleteTagParam)}
+}
+
+func (_c *MockClient_DeleteTag_Call) Run(run func(deleteTagParam api.DeleteTagParam)) *MockClient_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.DeleteTagParam
+		if args[0] != nil {
+			arg0 = args[0].(api.DeleteTagParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteTag_Call) Return(tag dto.Tag, err error) *MockClient_DeleteTag_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockClient_DeleteTag_Call) RunAndReturn(run func(deleteTagParam api.DeleteTagParam) (dto.Tag, error)) *MockClient_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteTask provides a mock function for the type MockClient
 func (_mock *MockClient) DeleteTask(deleteTaskParam api.DeleteTaskParam) (dto.Task, error) {
 	ret := _mock.Called(deleteTaskParam)
@@ -499,6 +681,66 @@ func (_c *MockClient_DeleteTimeEntry_Call) RunAndReturn(run func(deleteTimeEntry
 	return _c
 }
 
+// GetClient provides a mock function for the type MockClient
+func (_mock *MockClient) GetClient(getClientParam api.GetClientParam) (dto.Client, error) {
+	ret := _mock.Called(getClientParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClient")
+	}
+
+	var r0 dto.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.GetClientParam) (dto.Client, error)); ok {
+		return returnFunc(getClientParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.GetClientParam) dto.Client); ok {
+		r0 = returnFunc(getClientParam)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.GetClientParam) error); ok {
+		r1 = returnFunc(getClientParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClient'
+type MockClient_GetClient_Call struct {
+	*mock.Call
+}
+
+// GetClient is a helper method to define mock.On call
+//   - getClientParam api.GetClientParam
+func (_e *MockClient_Expecter) GetClient(getClientParam interface{}) *MockClient_GetClient_Call {
+	return &MockClient_GetClient_Call{Call: _e.mock.On("GetClient", getClientParam)}
+}
+
+func (_c *MockClient_GetClient_Call) Run(run func(getClientParam api.GetClientParam)) *MockClient_GetClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.GetClientParam
+		if args[0] != nil {
+			arg0 = args[0].(api.GetClientParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetClient_Call) Return(client dto.Client, err error) *MockClient_GetClient_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *MockClient_GetClient_Call) RunAndReturn(run func(getClientParam api.GetClientParam) (dto.Client, error)) *MockClient_GetClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClients provides a mock function for the type MockClient
 func (_mock *MockClient) GetClients(getClientsParam api.GetClientsParam) ([]dto.Client, error) {
 	ret := _mock.Called(getClientsParam)
@@ -1819,6 +2061,119 @@ func (_c *MockClient_SetInfoLogger_Call) RunAndReturn(run func(logger api.Logger
 	return _c
 }
 
+// SetRetryPolicy provides a mock function for the type MockClient
+func (_mock *MockClient) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	ret := _mock.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRetryPolicy")
+	}
+
+	var r0 api.Client
+	if returnFunc, ok := ret.Get(0).(func(api.RetryPolicy) api.Client); ok {
+		r0 = returnFunc(p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+	return r0
+}
+
+// MockClient_SetRetryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRetryPolicy'
+type MockClient_SetRetryPolicy_Call struct {
+	*mock.Call
+}
+
+// SetRetryPolicy is a helper method to define mock.On call
+//   - p api.RetryPolicy
+func (_e *MockClient_Expecter) SetRetryPolicy(p interface{}) *MockClient_SetRetryPolicy_Call {
+	return &MockClient_SetRetryPolicy_Call{Call: _e.mock.On("SetRetryPolicy", p)}
+}
+
+func (_c *MockClient_SetRetryPolicy_Call) Run(run func(p api.RetryPolicy)) *MockClient_SetRetryPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.RetryPolicy
+		if args[0] != nil {
+			arg0 = args[0].(api.RetryPolicy)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_SetRetryPolicy_Call) Return(client api.Client) *MockClient_SetRetryPolicy_Call {
+	_c.Call.Return(client)
+	return _c
+}
+
+func (_c *MockClient_SetRetryPolicy_Call) RunAndReturn(run func(p api.RetryPolicy) api.Client) *MockClient_SetRetryPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateClient provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateClient(updateClientParam api.UpdateClientParam) (dto.Client, error) {
+	ret := _mock.Called(updateClientParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClient")
+	}
+
+	var r0 dto.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.UpdateClientParam) (dto.Client, error)); ok {
+		return returnFunc(updateClientParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.UpdateClientParam) dto.Client); ok {
+		r0 = returnFunc(updateClientParam)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.UpdateClientParam) error); ok {
+		r1 = returnFunc(updateClientParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_UpdateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClient'
+type MockClient_UpdateClient_Call struct {
+	*mock.Call
+}
+
+// UpdateClient is a helper method to define mock.On call
+//   - updateClientParam api.UpdateClientParam
+func (_e *MockClient_Expecter) UpdateClient(updateClientParam interface{}) *MockClient_UpdateClient_Call {
+	return &MockClient_UpdateClient_Call{Call: _e.mock.On("UpdateClient", updateClientParam)}
+}
+
+func (_c *MockClient_UpdateClient_Call) Run(run func(updateClientParam api.UpdateClientParam)) *MockClient_UpdateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.UpdateClientParam
+		if args[0] != nil {
+			arg0 = args[0].(api.UpdateClientParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateClient_Call) Return(client dto.Client, err error) *MockClient_UpdateClient_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *MockClient_UpdateClient_Call) RunAndReturn(run func(updateClientParam api.UpdateClientParam) (dto.Client, error)) *MockClient_UpdateClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProject provides a mock function for the type MockClient
 func (_mock *MockClient) UpdateProject(updateProjectParam api.UpdateProjectParam) (dto.Project, error) {
 	ret := _mock.Called(updateProjectParam)
@@ -2179,6 +2534,66 @@ func (_c *MockClient_UpdateProjectUserCostRate_Call) RunAndReturn(run func(updat
 	return _c
 }
 
+// UpdateTag provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateTag(updateTagParam api.UpdateTagParam) (dto.Tag, error) {
+	ret := _mock.Called(updateTagParam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 dto.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.UpdateTagParam) (dto.Tag, error)); ok {
+		return returnFunc(updateTagParam)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.UpdateTagParam) dto.Tag); ok {
+		r0 = returnFunc(updateTagParam)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.UpdateTagParam) error); ok {
+		r1 = returnFunc(updateTagParam)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockClient_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - updateTagParam api.UpdateTagParam
+func (_e *MockClient_Expecter) UpdateTag(updateTagParam interface{}) *MockClient_UpdateTag_Call {
+	return &MockClient_UpdateTag_Call{Call: _e.mock.On("UpdateTag", updateTagParam)}
+}
+
+func (_c *MockClient_UpdateTag_Call) Run(run func(updateTagParam api.UpdateTagParam)) *MockClient_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.UpdateTagParam
+		if args[0] != nil {
+			arg0 = args[0].(api.UpdateTagParam)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateTag_Call) Return(tag dto.Tag, err error) *MockClient_UpdateTag_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockClient_UpdateTag_Call) RunAndReturn(run func(updateTagParam api.UpdateTagParam) (dto.Tag, error)) *MockClient_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTask provides a mock function for the type MockClient
 func (_mock *MockClient) UpdateTask(updateTaskParam api.UpdateTaskParam) (dto.Task, error) {
 	ret := _mock.Called(updateTaskParam)
@@ -2299,6 +2714,59 @@ func (_c *MockClient_UpdateTimeEntry_Call) RunAndReturn(run func(updateTimeEntry
 	return _c
 }
 
+// WithContext provides a mock function for the type MockClient
+func (_mock *MockClient) WithContext(ctx context.Context) api.Client {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 api.Client
+	if returnFunc, ok := ret.Get(0).(func(context.Context) api.Client); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+	return r0
+}
+
+// MockClient_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type MockClient_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClient_Expecter) WithContext(ctx interface{}) *MockClient_WithContext_Call {
+	return &MockClient_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *MockClient_WithContext_Call) Run(run func(ctx context.Context)) *MockClient_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_WithContext_Call) Return(client api.Client) *MockClient_WithContext_Call {
+	_c.Call.Return(client)
+	return _c
+}
+
+func (_c *MockClient_WithContext_Call) RunAndReturn(run func(ctx context.Context) api.Client) *MockClient_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WorkspaceUsers provides a mock function for the type MockClient
 func (_mock *MockClient) WorkspaceUsers(workspaceUsersParam api.WorkspaceUsersParam) ([]dto.User, error) {
 	ret := _mock.Called(workspaceUsersParam)
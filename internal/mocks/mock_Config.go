@@ -7,6 +7,7 @@ package mocks
 import (
 	"time"
 
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	mock "github.com/stretchr/testify/mock"
 	"golang.org/x/text/language"
 )
@@ -84,6 +85,145 @@ func (_c *MockConfig_All_Call) RunAndReturn(run func() map[string]interface{}) *
 	return _c
 }
 
+// CacheTTL provides a mock function for the type MockConfig
+func (_mock *MockConfig) CacheTTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CacheTTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_CacheTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CacheTTL'
+type MockConfig_CacheTTL_Call struct {
+	*mock.Call
+}
+
+// CacheTTL is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CacheTTL() *MockConfig_CacheTTL_Call {
+	return &MockConfig_CacheTTL_Call{Call: _e.mock.On("CacheTTL")}
+}
+
+func (_c *MockConfig_CacheTTL_Call) Run(run func()) *MockConfig_CacheTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_CacheTTL_Call) Return(duration time.Duration) *MockConfig_CacheTTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_CacheTTL_Call) RunAndReturn(run func() time.Duration) *MockConfig_CacheTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyHours provides a mock function for the type MockConfig
+func (_mock *MockConfig) DailyHours() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyHours")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_DailyHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyHours'
+type MockConfig_DailyHours_Call struct {
+	*mock.Call
+}
+
+// DailyHours is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DailyHours() *MockConfig_DailyHours_Call {
+	return &MockConfig_DailyHours_Call{Call: _e.mock.On("DailyHours")}
+}
+
+func (_c *MockConfig_DailyHours_Call) Run(run func()) *MockConfig_DailyHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DailyHours_Call) Return(duration time.Duration) *MockConfig_DailyHours_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_DailyHours_Call) RunAndReturn(run func() time.Duration) *MockConfig_DailyHours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyHoursOn provides a mock function for the type MockConfig
+func (_mock *MockConfig) DailyHoursOn(weekday time.Weekday) time.Duration {
+	ret := _mock.Called(weekday)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyHoursOn")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func(time.Weekday) time.Duration); ok {
+		r0 = returnFunc(weekday)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_DailyHoursOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyHoursOn'
+type MockConfig_DailyHoursOn_Call struct {
+	*mock.Call
+}
+
+// DailyHoursOn is a helper method to define mock.On call
+//   - weekday time.Weekday
+func (_e *MockConfig_Expecter) DailyHoursOn(weekday interface{}) *MockConfig_DailyHoursOn_Call {
+	return &MockConfig_DailyHoursOn_Call{Call: _e.mock.On("DailyHoursOn", weekday)}
+}
+
+func (_c *MockConfig_DailyHoursOn_Call) Run(run func(weekday time.Weekday)) *MockConfig_DailyHoursOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Weekday
+		if args[0] != nil {
+			arg0 = args[0].(time.Weekday)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_DailyHoursOn_Call) Return(duration time.Duration) *MockConfig_DailyHoursOn_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_DailyHoursOn_Call) RunAndReturn(run func(weekday time.Weekday) time.Duration) *MockConfig_DailyHoursOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockConfig
 func (_mock *MockConfig) Get(s string) interface{} {
 	ret := _mock.Called(s)
@@ -343,6 +483,61 @@ func (_c *MockConfig_GetStringSlice_Call) RunAndReturn(run func(s string) []stri
 	return _c
 }
 
+// GetTimeEntryTemplates provides a mock function for the type MockConfig
+func (_mock *MockConfig) GetTimeEntryTemplates() ([]cmdutil.TimeEntryTemplate, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeEntryTemplates")
+	}
+
+	var r0 []cmdutil.TimeEntryTemplate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]cmdutil.TimeEntryTemplate, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []cmdutil.TimeEntryTemplate); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cmdutil.TimeEntryTemplate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_GetTimeEntryTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeEntryTemplates'
+type MockConfig_GetTimeEntryTemplates_Call struct {
+	*mock.Call
+}
+
+// GetTimeEntryTemplates is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) GetTimeEntryTemplates() *MockConfig_GetTimeEntryTemplates_Call {
+	return &MockConfig_GetTimeEntryTemplates_Call{Call: _e.mock.On("GetTimeEntryTemplates")}
+}
+
+func (_c *MockConfig_GetTimeEntryTemplates_Call) Run(run func()) *MockConfig_GetTimeEntryTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_GetTimeEntryTemplates_Call) Return(timeEntryTemplates []cmdutil.TimeEntryTemplate, err error) *MockConfig_GetTimeEntryTemplates_Call {
+	_c.Call.Return(timeEntryTemplates, err)
+	return _c
+}
+
+func (_c *MockConfig_GetTimeEntryTemplates_Call) RunAndReturn(run func() ([]cmdutil.TimeEntryTemplate, error)) *MockConfig_GetTimeEntryTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkWeekdays provides a mock function for the type MockConfig
 func (_mock *MockConfig) GetWorkWeekdays() []string {
 	ret := _mock.Called()
@@ -389,6 +584,50 @@ func (_c *MockConfig_GetWorkWeekdays_Call) RunAndReturn(run func() []string) *Mo
 	return _c
 }
 
+// IdleThreshold provides a mock function for the type MockConfig
+func (_mock *MockConfig) IdleThreshold() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdleThreshold")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_IdleThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdleThreshold'
+type MockConfig_IdleThreshold_Call struct {
+	*mock.Call
+}
+
+// IdleThreshold is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) IdleThreshold() *MockConfig_IdleThreshold_Call {
+	return &MockConfig_IdleThreshold_Call{Call: _e.mock.On("IdleThreshold")}
+}
+
+func (_c *MockConfig_IdleThreshold_Call) Run(run func()) *MockConfig_IdleThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_IdleThreshold_Call) Return(duration time.Duration) *MockConfig_IdleThreshold_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_IdleThreshold_Call) RunAndReturn(run func() time.Duration) *MockConfig_IdleThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InteractivePageSize provides a mock function for the type MockConfig
 func (_mock *MockConfig) InteractivePageSize() int {
 	ret := _mock.Called()
@@ -697,6 +936,94 @@ func (_c *MockConfig_LogLevel_Call) RunAndReturn(run func() string) *MockConfig_
 	return _c
 }
 
+// RetryAttempts provides a mock function for the type MockConfig
+func (_mock *MockConfig) RetryAttempts() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryAttempts")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_RetryAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryAttempts'
+type MockConfig_RetryAttempts_Call struct {
+	*mock.Call
+}
+
+// RetryAttempts is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RetryAttempts() *MockConfig_RetryAttempts_Call {
+	return &MockConfig_RetryAttempts_Call{Call: _e.mock.On("RetryAttempts")}
+}
+
+func (_c *MockConfig_RetryAttempts_Call) Run(run func()) *MockConfig_RetryAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RetryAttempts_Call) Return(n int) *MockConfig_RetryAttempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_RetryAttempts_Call) RunAndReturn(run func() int) *MockConfig_RetryAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMaxDelay provides a mock function for the type MockConfig
+func (_mock *MockConfig) RetryMaxDelay() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMaxDelay")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_RetryMaxDelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMaxDelay'
+type MockConfig_RetryMaxDelay_Call struct {
+	*mock.Call
+}
+
+// RetryMaxDelay is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RetryMaxDelay() *MockConfig_RetryMaxDelay_Call {
+	return &MockConfig_RetryMaxDelay_Call{Call: _e.mock.On("RetryMaxDelay")}
+}
+
+func (_c *MockConfig_RetryMaxDelay_Call) Run(run func()) *MockConfig_RetryMaxDelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RetryMaxDelay_Call) Return(duration time.Duration) *MockConfig_RetryMaxDelay_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_RetryMaxDelay_Call) RunAndReturn(run func() time.Duration) *MockConfig_RetryMaxDelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type MockConfig
 func (_mock *MockConfig) Save() error {
 	ret := _mock.Called()
@@ -965,6 +1292,46 @@ func (_c *MockConfig_SetStringSlice_Call) RunAndReturn(run func(s string, string
 	return _c
 }
 
+// SetTimeEntryTemplates provides a mock function for the type MockConfig
+func (_mock *MockConfig) SetTimeEntryTemplates(timeEntryTemplates []cmdutil.TimeEntryTemplate) {
+	_mock.Called(timeEntryTemplates)
+	return
+}
+
+// MockConfig_SetTimeEntryTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTimeEntryTemplates'
+type MockConfig_SetTimeEntryTemplates_Call struct {
+	*mock.Call
+}
+
+// SetTimeEntryTemplates is a helper method to define mock.On call
+//   - timeEntryTemplates []cmdutil.TimeEntryTemplate
+func (_e *MockConfig_Expecter) SetTimeEntryTemplates(timeEntryTemplates interface{}) *MockConfig_SetTimeEntryTemplates_Call {
+	return &MockConfig_SetTimeEntryTemplates_Call{Call: _e.mock.On("SetTimeEntryTemplates", timeEntryTemplates)}
+}
+
+func (_c *MockConfig_SetTimeEntryTemplates_Call) Run(run func(timeEntryTemplates []cmdutil.TimeEntryTemplate)) *MockConfig_SetTimeEntryTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []cmdutil.TimeEntryTemplate
+		if args[0] != nil {
+			arg0 = args[0].([]cmdutil.TimeEntryTemplate)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_SetTimeEntryTemplates_Call) Return() *MockConfig_SetTimeEntryTemplates_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockConfig_SetTimeEntryTemplates_Call) RunAndReturn(run func(timeEntryTemplates []cmdutil.TimeEntryTemplate)) *MockConfig_SetTimeEntryTemplates_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetTimeZone provides a mock function for the type MockConfig
 func (_mock *MockConfig) SetTimeZone(location *time.Location) {
 	_mock.Called(location)
@@ -1005,6 +1372,50 @@ func (_c *MockConfig_SetTimeZone_Call) RunAndReturn(run func(location *time.Loca
 	return _c
 }
 
+// StatusTTL provides a mock function for the type MockConfig
+func (_mock *MockConfig) StatusTTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusTTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_StatusTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatusTTL'
+type MockConfig_StatusTTL_Call struct {
+	*mock.Call
+}
+
+// StatusTTL is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) StatusTTL() *MockConfig_StatusTTL_Call {
+	return &MockConfig_StatusTTL_Call{Call: _e.mock.On("StatusTTL")}
+}
+
+func (_c *MockConfig_StatusTTL_Call) Run(run func()) *MockConfig_StatusTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_StatusTTL_Call) Return(duration time.Duration) *MockConfig_StatusTTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_StatusTTL_Call) RunAndReturn(run func() time.Duration) *MockConfig_StatusTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TimeZone provides a mock function for the type MockConfig
 func (_mock *MockConfig) TimeZone() *time.Location {
 	ret := _mock.Called()
@@ -1050,3 +1461,47 @@ func (_c *MockConfig_TimeZone_Call) RunAndReturn(run func() *time.Location) *Moc
 	_c.Call.Return(run)
 	return _c
 }
+
+// WorkdayEnd provides a mock function for the type MockConfig
+func (_mock *MockConfig) WorkdayEnd() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkdayEnd")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_WorkdayEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkdayEnd'
+type MockConfig_WorkdayEnd_Call struct {
+	*mock.Call
+}
+
+// WorkdayEnd is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) WorkdayEnd() *MockConfig_WorkdayEnd_Call {
+	return &MockConfig_WorkdayEnd_Call{Call: _e.mock.On("WorkdayEnd")}
+}
+
+func (_c *MockConfig_WorkdayEnd_Call) Run(run func()) *MockConfig_WorkdayEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_WorkdayEnd_Call) Return(duration time.Duration) *MockConfig_WorkdayEnd_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_WorkdayEnd_Call) RunAndReturn(run func() time.Duration) *MockConfig_WorkdayEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
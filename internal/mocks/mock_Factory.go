@@ -5,10 +5,17 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/lucassabreu/clockify-cli/pkg/running"
 	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,6 +46,61 @@ func (_m *MockFactory) EXPECT() *MockFactory_Expecter {
 	return &MockFactory_Expecter{mock: &_m.Mock}
 }
 
+// Cache provides a mock function for the type MockFactory
+func (_mock *MockFactory) Cache() (*cache.Store, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cache")
+	}
+
+	var r0 *cache.Store
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*cache.Store, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *cache.Store); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cache.Store)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFactory_Cache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cache'
+type MockFactory_Cache_Call struct {
+	*mock.Call
+}
+
+// Cache is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Cache() *MockFactory_Cache_Call {
+	return &MockFactory_Cache_Call{Call: _e.mock.On("Cache")}
+}
+
+func (_c *MockFactory_Cache_Call) Run(run func()) *MockFactory_Cache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Cache_Call) Return(store *cache.Store, err error) *MockFactory_Cache_Call {
+	_c.Call.Return(store, err)
+	return _c
+}
+
+func (_c *MockFactory_Cache_Call) RunAndReturn(run func() (*cache.Store, error)) *MockFactory_Cache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Client provides a mock function for the type MockFactory
 func (_mock *MockFactory) Client() (api.Client, error) {
 	ret := _mock.Called()
@@ -140,6 +202,52 @@ func (_c *MockFactory_Config_Call) RunAndReturn(run func() cmdutil.Config) *Mock
 	return _c
 }
 
+// Context provides a mock function for the type MockFactory
+func (_mock *MockFactory) Context() context.Context {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Context")
+	}
+
+	var r0 context.Context
+	if returnFunc, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	return r0
+}
+
+// MockFactory_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type MockFactory_Context_Call struct {
+	*mock.Call
+}
+
+// Context is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Context() *MockFactory_Context_Call {
+	return &MockFactory_Context_Call{Call: _e.mock.On("Context")}
+}
+
+func (_c *MockFactory_Context_Call) Run(run func()) *MockFactory_Context_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Context_Call) Return(ctx context.Context) *MockFactory_Context_Call {
+	_c.Call.Return(ctx)
+	return _c
+}
+
+func (_c *MockFactory_Context_Call) RunAndReturn(run func() context.Context) *MockFactory_Context_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserID provides a mock function for the type MockFactory
 func (_mock *MockFactory) GetUserID() (string, error) {
 	ret := _mock.Called()
@@ -299,6 +407,226 @@ func (_c *MockFactory_GetWorkspaceID_Call) RunAndReturn(run func() (string, erro
 	return _c
 }
 
+// ImportLedger provides a mock function for the type MockFactory
+func (_mock *MockFactory) ImportLedger() (*importer.Ledger, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLedger")
+	}
+
+	var r0 *importer.Ledger
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*importer.Ledger, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *importer.Ledger); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*importer.Ledger)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFactory_ImportLedger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLedger'
+type MockFactory_ImportLedger_Call struct {
+	*mock.Call
+}
+
+// ImportLedger is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) ImportLedger() *MockFactory_ImportLedger_Call {
+	return &MockFactory_ImportLedger_Call{Call: _e.mock.On("ImportLedger")}
+}
+
+func (_c *MockFactory_ImportLedger_Call) Run(run func()) *MockFactory_ImportLedger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_ImportLedger_Call) Return(ledger *importer.Ledger, err error) *MockFactory_ImportLedger_Call {
+	_c.Call.Return(ledger, err)
+	return _c
+}
+
+func (_c *MockFactory_ImportLedger_Call) RunAndReturn(run func() (*importer.Ledger, error)) *MockFactory_ImportLedger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Journal provides a mock function for the type MockFactory
+func (_mock *MockFactory) Journal() (*journal.Journal, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Journal")
+	}
+
+	var r0 *journal.Journal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*journal.Journal, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *journal.Journal); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*journal.Journal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFactory_Journal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Journal'
+type MockFactory_Journal_Call struct {
+	*mock.Call
+}
+
+// Journal is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Journal() *MockFactory_Journal_Call {
+	return &MockFactory_Journal_Call{Call: _e.mock.On("Journal")}
+}
+
+func (_c *MockFactory_Journal_Call) Run(run func()) *MockFactory_Journal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Journal_Call) Return(journal *journal.Journal, err error) *MockFactory_Journal_Call {
+	_c.Call.Return(journal, err)
+	return _c
+}
+
+func (_c *MockFactory_Journal_Call) RunAndReturn(run func() (*journal.Journal, error)) *MockFactory_Journal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Running provides a mock function for the type MockFactory
+func (_mock *MockFactory) Running() (*running.Store, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Running")
+	}
+
+	var r0 *running.Store
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*running.Store, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *running.Store); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*running.Store)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFactory_Running_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Running'
+type MockFactory_Running_Call struct {
+	*mock.Call
+}
+
+// Running is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Running() *MockFactory_Running_Call {
+	return &MockFactory_Running_Call{Call: _e.mock.On("Running")}
+}
+
+func (_c *MockFactory_Running_Call) Run(run func()) *MockFactory_Running_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Running_Call) Return(store *running.Store, err error) *MockFactory_Running_Call {
+	_c.Call.Return(store, err)
+	return _c
+}
+
+func (_c *MockFactory_Running_Call) RunAndReturn(run func() (*running.Store, error)) *MockFactory_Running_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TimeOff provides a mock function for the type MockFactory
+func (_mock *MockFactory) TimeOff() (*worktime.Calendar, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TimeOff")
+	}
+
+	var r0 *worktime.Calendar
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*worktime.Calendar, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *worktime.Calendar); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*worktime.Calendar)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFactory_TimeOff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TimeOff'
+type MockFactory_TimeOff_Call struct {
+	*mock.Call
+}
+
+// TimeOff is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) TimeOff() *MockFactory_TimeOff_Call {
+	return &MockFactory_TimeOff_Call{Call: _e.mock.On("TimeOff")}
+}
+
+func (_c *MockFactory_TimeOff_Call) Run(run func()) *MockFactory_TimeOff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_TimeOff_Call) Return(calendar *worktime.Calendar, err error) *MockFactory_TimeOff_Call {
+	_c.Call.Return(calendar, err)
+	return _c
+}
+
+func (_c *MockFactory_TimeOff_Call) RunAndReturn(run func() (*worktime.Calendar, error)) *MockFactory_TimeOff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UI provides a mock function for the type MockFactory
 func (_mock *MockFactory) UI() ui.UI {
 	ret := _mock.Called()
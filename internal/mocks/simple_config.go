@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
@@ -27,6 +28,18 @@ type SimpleConfig struct {
 	SearchProjectWithClientsName bool
 	LanguageTag                  language.Tag
 	TimeZoneLoc                  *time.Location
+	CacheTTLDuration             time.Duration
+	StatusTTLDuration            time.Duration
+	RetryAttemptsNumber          int
+	RetryMaxDelayDuration        time.Duration
+	TimeEntryTemplates           []cmdutil.TimeEntryTemplate
+	DailyHoursDuration           time.Duration
+	PomodoroBreakProject         string
+	PomodoroNotify               string
+	IdleThresholdDuration        time.Duration
+	WorkdayEndDuration           time.Duration
+	WeekdayHours                 map[time.Weekday]time.Duration
+	BalanceStart                 string
 }
 
 func (d *SimpleConfig) GetBool(n string) bool {
@@ -79,6 +92,12 @@ func (d *SimpleConfig) GetString(n string) string {
 		return d.Token
 	case cmdutil.CONF_LOG_LEVEL:
 		return d.LogLevelValue
+	case cmdutil.CONF_POMODORO_BREAK_PROJECT:
+		return d.PomodoroBreakProject
+	case cmdutil.CONF_POMODORO_NOTIFY:
+		return d.PomodoroNotify
+	case cmdutil.CONF_BALANCE_START:
+		return d.BalanceStart
 	default:
 		return ""
 
@@ -164,6 +183,70 @@ func (s *SimpleConfig) InteractivePageSize() int {
 	return s.InteractivePageSizeNumber
 }
 
+// CacheTTL is for how long workspace metadata can be reused from the local
+// cache
+func (s *SimpleConfig) CacheTTL() time.Duration {
+	return s.CacheTTLDuration
+}
+
+// StatusTTL is for how long the time entry running can be reused from the
+// local state
+func (s *SimpleConfig) StatusTTL() time.Duration {
+	return s.StatusTTLDuration
+}
+
+// RetryAttempts is how many times a failed request is retried
+func (s *SimpleConfig) RetryAttempts() int {
+	return s.RetryAttemptsNumber
+}
+
+// RetryMaxDelay is the longest wait between retries
+func (s *SimpleConfig) RetryMaxDelay() time.Duration {
+	return s.RetryMaxDelayDuration
+}
+
+// DailyHours is how long the user is expected to work on each of the
+// workweek days
+func (s *SimpleConfig) DailyHours() time.Duration {
+	return s.DailyHoursDuration
+}
+
+// DailyHoursOn is how long the user is expected to work on the weekday
+func (s *SimpleConfig) DailyHoursOn(wd time.Weekday) time.Duration {
+	if h, ok := s.WeekdayHours[wd]; ok {
+		return h
+	}
+
+	for _, w := range s.WorkweekDays {
+		if strings.EqualFold(w, wd.String()) {
+			return s.DailyHoursDuration
+		}
+	}
+
+	return 0
+}
+
+// IdleThreshold is how long a time entry can run before being considered
+// forgotten
+func (s *SimpleConfig) IdleThreshold() time.Duration {
+	return s.IdleThresholdDuration
+}
+
+// WorkdayEnd is the time of the day the user stops working
+func (s *SimpleConfig) WorkdayEnd() time.Duration {
+	return s.WorkdayEndDuration
+}
+
+// GetTimeEntryTemplates retrieves the time entry templates saved
+func (s *SimpleConfig) GetTimeEntryTemplates() (
+	[]cmdutil.TimeEntryTemplate, error) {
+	return s.TimeEntryTemplates, nil
+}
+
+func (*SimpleConfig) SetTimeEntryTemplates(_ []cmdutil.TimeEntryTemplate) {
+	panic("should not call")
+}
+
 func (*SimpleConfig) Save() error {
 	panic("should not call")
 }
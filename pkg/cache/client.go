@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type client struct {
+	api.Client
+	s *Store
+}
+
+// NewClient decorates a api.Client so read-only lookups of workspaces,
+// projects, tasks, tags, clients and users are looked up on the Store first,
+// and changes to those entities invalidate their cached entries
+func NewClient(c api.Client, s *Store) api.Client {
+	return &client{Client: c, s: s}
+}
+
+// cached will return the value stored for the params if there is one fresh,
+// otherwise will call fn and store its result. Failures of the cache are
+// ignored, as the API is always the source of truth.
+func cached[P any, R any](
+	s *Store, workspace string, e Entity, p P, fn func(P) (R, error),
+) (R, error) {
+	var r R
+	k, err := json.Marshal(p)
+	if err != nil {
+		return fn(p)
+	}
+
+	if ok, _ := s.Get(workspace, e, string(k), &r); ok {
+		return r, nil
+	}
+
+	r, err = fn(p)
+	if err != nil {
+		return r, err
+	}
+
+	_ = s.Set(workspace, e, string(k), r)
+	return r, nil
+}
+
+func (c *client) invalidate(workspace string, es ...Entity) {
+	_ = c.s.Invalidate(workspace, es...)
+}
+
+func (c *client) SetDebugLogger(logger api.Logger) api.Client {
+	c.Client.SetDebugLogger(logger)
+	return c
+}
+
+func (c *client) SetInfoLogger(logger api.Logger) api.Client {
+	c.Client.SetInfoLogger(logger)
+	return c
+}
+
+func (c *client) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	c.Client.SetRetryPolicy(p)
+	return c
+}
+
+func (c *client) WithContext(ctx context.Context) api.Client {
+	return &client{Client: c.Client.WithContext(ctx), s: c.s}
+}
+
+func (c *client) GetWorkspace(p api.GetWorkspace) (dto.Workspace, error) {
+	return cached(c.s, p.ID, Workspaces, p, c.Client.GetWorkspace)
+}
+
+func (c *client) WorkspaceUsers(p api.WorkspaceUsersParam) ([]dto.User, error) {
+	return cached(c.s, p.Workspace, Users, p, c.Client.WorkspaceUsers)
+}
+
+func (c *client) GetClients(p api.GetClientsParam) ([]dto.Client, error) {
+	return cached(c.s, p.Workspace, Clients, p, c.Client.GetClients)
+}
+
+func (c *client) GetClient(p api.GetClientParam) (dto.Client, error) {
+	return cached(c.s, p.Workspace, Clients, p, c.Client.GetClient)
+}
+
+func (c *client) AddClient(p api.AddClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, Clients)
+	return c.Client.AddClient(p)
+}
+
+func (c *client) UpdateClient(p api.UpdateClientParam) (dto.Client, error) {
+	// projects have the client's name on them
+	defer c.invalidate(p.Workspace, Clients, Projects)
+	return c.Client.UpdateClient(p)
+}
+
+func (c *client) DeleteClient(p api.DeleteClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, Clients, Projects)
+	return c.Client.DeleteClient(p)
+}
+
+func (c *client) GetProjects(p api.GetProjectsParam) ([]dto.Project, error) {
+	return cached(c.s, p.Workspace, Projects, p, c.Client.GetProjects)
+}
+
+func (c *client) GetProject(p api.GetProjectParam) (*dto.Project, error) {
+	return cached(c.s, p.Workspace, Projects, p, c.Client.GetProject)
+}
+
+func (c *client) AddProject(p api.AddProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.AddProject(p)
+}
+
+func (c *client) UpdateProject(p api.UpdateProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProject(p)
+}
+
+func (c *client) UpdateProjectUserBillableRate(
+	p api.UpdateProjectUserRateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProjectUserBillableRate(p)
+}
+
+func (c *client) UpdateProjectUserCostRate(
+	p api.UpdateProjectUserRateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProjectUserCostRate(p)
+}
+
+func (c *client) UpdateProjectEstimate(
+	p api.UpdateProjectEstimateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProjectEstimate(p)
+}
+
+func (c *client) UpdateProjectMemberships(
+	p api.UpdateProjectMembershipsParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProjectMemberships(p)
+}
+
+func (c *client) UpdateProjectTemplate(
+	p api.UpdateProjectTemplateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects)
+	return c.Client.UpdateProjectTemplate(p)
+}
+
+func (c *client) DeleteProject(p api.DeleteProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, Projects, Tasks)
+	return c.Client.DeleteProject(p)
+}
+
+func (c *client) GetTasks(p api.GetTasksParam) ([]dto.Task, error) {
+	return cached(c.s, p.Workspace, Tasks, p, c.Client.GetTasks)
+}
+
+func (c *client) GetTask(p api.GetTaskParam) (dto.Task, error) {
+	return cached(c.s, p.Workspace, Tasks, p, c.Client.GetTask)
+}
+
+// hydrated projects have their tasks on them, so changing tasks also
+// invalidate projects
+
+func (c *client) AddTask(p api.AddTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, Tasks, Projects)
+	return c.Client.AddTask(p)
+}
+
+func (c *client) UpdateTask(p api.UpdateTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, Tasks, Projects)
+	return c.Client.UpdateTask(p)
+}
+
+func (c *client) DeleteTask(p api.DeleteTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, Tasks, Projects)
+	return c.Client.DeleteTask(p)
+}
+
+func (c *client) GetTags(p api.GetTagsParam) ([]dto.Tag, error) {
+	return cached(c.s, p.Workspace, Tags, p, c.Client.GetTags)
+}
+
+func (c *client) GetTag(p api.GetTagParam) (*dto.Tag, error) {
+	return cached(c.s, p.Workspace, Tags, p, c.Client.GetTag)
+}
+
+func (c *client) AddTag(p api.AddTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, Tags)
+	return c.Client.AddTag(p)
+}
+
+func (c *client) UpdateTag(p api.UpdateTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, Tags)
+	return c.Client.UpdateTag(p)
+}
+
+func (c *client) DeleteTag(p api.DeleteTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, Tags)
+	return c.Client.DeleteTag(p)
+}
@@ -0,0 +1,89 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientReadsFromCache(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	b := false
+	p := api.GetProjectsParam{
+		Workspace:       "w",
+		Archived:        &b,
+		PaginationParam: api.AllPages(),
+	}
+	ps := []dto.Project{{ID: "p1", Name: "Project 1"}}
+	m.EXPECT().GetProjects(p).Return(ps, nil).Once()
+
+	for i := 0; i < 3; i++ {
+		r, err := c.GetProjects(p)
+		assert.NoError(t, err)
+		assert.Equal(t, ps, r)
+	}
+
+	all := api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}
+	m.EXPECT().GetProjects(all).Return(ps, nil).Once()
+	_, err := c.GetProjects(all)
+	assert.NoError(t, err, "different params should not share entries")
+}
+
+func TestClientDoesNotCacheErrors(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	p := api.GetTagsParam{Workspace: "w"}
+	m.EXPECT().GetTags(p).Return(nil, errors.New("http error")).Once()
+	m.EXPECT().GetTags(p).Return([]dto.Tag{{ID: "t1"}}, nil).Once()
+
+	_, err := c.GetTags(p)
+	assert.EqualError(t, err, "http error")
+
+	ts, err := c.GetTags(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.Tag{{ID: "t1"}}, ts)
+}
+
+func TestClientMutationsInvalidate(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	pc := api.GetClientsParam{Workspace: "w"}
+	pp := api.GetProjectsParam{Workspace: "w"}
+	pt := api.GetTagsParam{Workspace: "w"}
+
+	m.EXPECT().GetClients(pc).Return([]dto.Client{{ID: "c1"}}, nil).Times(2)
+	m.EXPECT().GetProjects(pp).Return([]dto.Project{{ID: "p1"}}, nil).Times(2)
+	m.EXPECT().GetTags(pt).Return([]dto.Tag{{ID: "t1"}}, nil).Once()
+
+	load := func() {
+		_, err := c.GetClients(pc)
+		assert.NoError(t, err)
+		_, err = c.GetProjects(pp)
+		assert.NoError(t, err)
+		_, err = c.GetTags(pt)
+		assert.NoError(t, err)
+	}
+
+	load()
+
+	up := api.UpdateClientParam{Workspace: "w", ClientID: "c1", Name: "n"}
+	m.EXPECT().UpdateClient(up).Return(dto.Client{ID: "c1"}, nil).Once()
+	_, err := c.UpdateClient(up)
+	assert.NoError(t, err)
+
+	// clients and projects must be fetched again, tags are still cached
+	load()
+}
@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Entity identifies which type of metadata is being cached, each entity type
+// is stored and invalidated on its own
+type Entity string
+
+const (
+	Workspaces Entity = "workspaces"
+	Projects   Entity = "projects"
+	Tasks      Entity = "tasks"
+	Tags       Entity = "tags"
+	Clients    Entity = "clients"
+	Users      Entity = "users"
+)
+
+// Entities lists all entity types that are cached
+func Entities() []Entity {
+	return []Entity{Workspaces, Projects, Tasks, Tags, Clients, Users}
+}
+
+type entry struct {
+	FetchedAt time.Time       `json:"fetchedAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Store persists workspace metadata on disk, so it can be reused between
+// executions of the CLI while it is not older than its TTL
+type Store struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+
+	m sync.Mutex
+}
+
+// StoreOption changes optional behaviors of the Store
+type StoreOption func(*Store)
+
+// WithNow sets which function will be used to get the current time
+func WithNow(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore creates a Store that keeps its files on dir and considers entries
+// older than ttl as expired
+func NewStore(dir string, ttl time.Duration, opts ...StoreOption) *Store {
+	s := &Store{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// Dir returns where the cache files are stored
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// TTL returns for how long an entry is considered fresh
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+func (s *Store) filename(workspace string, e Entity) string {
+	return filepath.Join(s.dir, workspace, string(e)+".json")
+}
+
+func (s *Store) load(workspace string, e Entity) (map[string]entry, error) {
+	entries := map[string]entry{}
+	b, err := os.ReadFile(s.filename(workspace, e))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+
+		return entries, errors.WithStack(err)
+	}
+
+	if err := json.Unmarshal(b, &entries); err != nil {
+		// a corrupted file is the same as a empty cache
+		return map[string]entry{}, nil
+	}
+
+	return entries, nil
+}
+
+// Get looks for a fresh entry for key and unmarshal it into v, it returns
+// false if there is no entry or it is expired
+func (s *Store) Get(
+	workspace string, e Entity, key string, v interface{}) (bool, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	entries, err := s.load(workspace, e)
+	if err != nil {
+		return false, err
+	}
+
+	en, ok := entries[key]
+	if !ok || s.now().Sub(en.FetchedAt) > s.ttl {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(en.Data, v); err != nil {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Set stores v as the value for key
+func (s *Store) Set(
+	workspace string, e Entity, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	entries, err := s.load(workspace, e)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	for k, en := range entries {
+		if now.Sub(en.FetchedAt) > s.ttl {
+			delete(entries, k)
+		}
+	}
+
+	entries[key] = entry{FetchedAt: now, Data: data}
+
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	filename := s.filename(workspace, e)
+	if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// writing to a temporary file first so other executions don't read a
+	// partial file
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+string(e)+"-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(f.Name(), filename))
+}
+
+// Invalidate removes all entries of the entity types informed for the
+// workspace
+func (s *Store) Invalidate(workspace string, es ...Entity) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for _, e := range es {
+		err := os.Remove(s.filename(workspace, e))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// Clear removes all cached entries of the workspace, or of all workspaces if
+// none is informed
+func (s *Store) Clear(workspace string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	dir := s.dir
+	if workspace != "" {
+		dir = filepath.Join(s.dir, workspace)
+	}
+
+	return errors.WithStack(os.RemoveAll(dir))
+}
@@ -0,0 +1,70 @@
+package cache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	s := cache.NewStore(t.TempDir(), time.Hour,
+		cache.WithNow(func() time.Time { return now }))
+
+	var v []string
+	ok, err := s.Get("w", cache.Tags, "k", &v)
+	assert.NoError(t, err)
+	assert.False(t, ok, "empty cache should not have entries")
+
+	assert.NoError(t, s.Set("w", cache.Tags, "k", []string{"a", "b"}))
+	assert.NoError(t, s.Set("w", cache.Clients, "k", []string{"c"}))
+
+	ok, err = s.Get("w", cache.Tags, "k", &v)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, v)
+
+	ok, _ = s.Get("other", cache.Tags, "k", &v)
+	assert.False(t, ok, "workspaces should not share entries")
+
+	now = now.Add(time.Hour + time.Second)
+	ok, _ = s.Get("w", cache.Tags, "k", &v)
+	assert.False(t, ok, "expired entries should not be returned")
+
+	assert.NoError(t, s.Set("w", cache.Tags, "k", []string{"d"}))
+	assert.NoError(t, s.Invalidate("w", cache.Tags))
+
+	ok, _ = s.Get("w", cache.Tags, "k", &v)
+	assert.False(t, ok, "invalidated entity should not have entries")
+
+	ok, _ = s.Get("w", cache.Clients, "k", &v)
+	assert.False(t, ok, "other entities are kept, but this one is expired")
+
+	assert.NoError(t, s.Set("w", cache.Clients, "k", []string{"c"}))
+	assert.NoError(t, s.Clear("w"))
+	_, err = os.Stat(filepath.Join(s.Dir(), "w"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestStoreCorruptedFile(t *testing.T) {
+	dir := t.TempDir()
+	s := cache.NewStore(dir, time.Hour)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "w"), 0o700))
+	assert.NoError(t, os.WriteFile(
+		filepath.Join(dir, "w", "tags.json"), []byte("{not json"), 0o600))
+
+	var v []string
+	ok, err := s.Get("w", cache.Tags, "k", &v)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, s.Set("w", cache.Tags, "k", []string{"a"}))
+	ok, _ = s.Get("w", cache.Tags, "k", &v)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a"}, v)
+}
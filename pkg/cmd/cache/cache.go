@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/clear"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/refresh"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdCache represents the cache command
+func NewCmdCache(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manages the local cache of workspace metadata",
+		Long: heredoc.Doc(`
+			Projects, tasks, tags, clients and users can be kept on a local
+			cache (at $HOME/.config/clockify-cli/cache, one for each API key) to
+			make name lookups and shell completion faster.
+
+			The cache is disabled by default. When the "cache-ttl" config is
+			set, entries are reused while they are younger than it, and any
+			change made through the CLI to a entity type invalidates its
+			entries.
+		`),
+		Example: heredoc.Doc(`
+			# enable the cache, keeping entries for thirty minutes
+			$ clockify-cli config set cache-ttl 30m
+
+			# disable the cache
+			$ clockify-cli config set cache-ttl 0
+		`),
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(refresh.NewCmdRefresh(f))
+	cmd.AddCommand(clear.NewCmdClear(f))
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package clear
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdClear represents the cache clear command
+func NewCmdClear(f cmdutil.Factory) *cobra.Command {
+	var all bool
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Removes the cached metadata of the current workspace",
+		Example: heredoc.Doc(`
+			$ clockify-cli cache clear
+
+			# removes the cache of all workspaces
+			$ clockify-cli cache clear --all
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := f.Cache()
+			if err != nil {
+				return err
+			}
+
+			if all {
+				return s.Clear("")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			return s.Clear(w)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false,
+		"clear the cache of all workspaces")
+
+	return cmd
+}
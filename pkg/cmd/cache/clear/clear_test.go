@@ -0,0 +1,77 @@
+package clear_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/clear"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdClear(t *testing.T) {
+	tts := []struct {
+		name    string
+		args    []string
+		removed []string
+		kept    []string
+	}{
+		{
+			name:    "current workspace",
+			removed: []string{"key1/w"},
+			kept:    []string{"key1/w2", "key2/w"},
+		},
+		{
+			name:    "all workspaces",
+			args:    []string{"--all"},
+			removed: []string{"key1"},
+			kept:    []string{"key2/w"},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			// each API key has its own store, only the current one should be
+			// cleared
+			s := cache.NewStore(filepath.Join(dir, "key1"), time.Hour)
+			other := cache.NewStore(filepath.Join(dir, "key2"), time.Hour)
+			tags := []dto.Tag{{ID: "t1"}}
+			assert.NoError(t, s.Set("w", cache.Tags, "k", tags))
+			assert.NoError(t, s.Set("w2", cache.Tags, "k", tags))
+			assert.NoError(t, other.Set("w", cache.Tags, "k", tags))
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().Cache().Return(s, nil)
+			f.EXPECT().GetWorkspaceID().Return("w", nil).Maybe()
+
+			cmd := clear.NewCmdClear(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err := cmd.ExecuteC()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, p := range tt.removed {
+				_, err := os.Stat(filepath.Join(dir, p))
+				assert.True(t, os.IsNotExist(err), p+" should be removed")
+			}
+
+			for _, p := range tt.kept {
+				_, err := os.Stat(filepath.Join(dir, p, "tags.json"))
+				assert.NoError(t, err, p+" should be kept")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package refresh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdRefresh represents the cache refresh command
+func NewCmdRefresh(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Short: "Fetches again the metadata of the current workspace",
+		Long: heredoc.Doc(`
+			Removes the cached metadata of the current workspace and loads the
+			projects, clients, tags and users again.
+
+			Tasks are loaded when first needed.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli cache refresh
+			12 projects, 3 clients, 8 tags and 5 users cached
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if f.Config().CacheTTL() <= 0 {
+				return errors.New(
+					"cache is disabled, set \"cache-ttl\" to enable it")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			s, err := f.Cache()
+			if err != nil {
+				return err
+			}
+
+			if err := s.Clear(w); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			n, err := load(cmd.Context(), c, w)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(),
+				"%d projects, %d clients, %d tags and %d users cached\n",
+				n[cache.Projects], n[cache.Clients], n[cache.Tags],
+				n[cache.Users])
+			return err
+		},
+	}
+
+	return cmd
+}
+
+// load requests the same lists used by name lookups and shell completion,
+// so the cached client will store them
+func load(
+	ctx context.Context, c api.Client, w string,
+) (map[cache.Entity]int, error) {
+	g, ctx := errgroup.WithContext(ctx)
+	c = c.WithContext(ctx)
+	var projects, clients, tags, users int
+	active := false
+
+	for _, archived := range []*bool{nil, &active} {
+		archived := archived
+		all := archived == nil
+
+		g.Go(func() error {
+			ps, err := c.GetProjects(api.GetProjectsParam{
+				Workspace:       w,
+				Archived:        archived,
+				PaginationParam: api.AllPages(),
+			})
+			if all {
+				projects = len(ps)
+			}
+			return err
+		})
+
+		g.Go(func() error {
+			cs, err := c.GetClients(api.GetClientsParam{
+				Workspace:       w,
+				Archived:        archived,
+				PaginationParam: api.AllPages(),
+			})
+			if all {
+				clients = len(cs)
+			}
+			return err
+		})
+
+		g.Go(func() error {
+			ts, err := c.GetTags(api.GetTagsParam{
+				Workspace:       w,
+				Archived:        archived,
+				PaginationParam: api.AllPages(),
+			})
+			if all {
+				tags = len(ts)
+			}
+			return err
+		})
+	}
+
+	g.Go(func() error {
+		us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+			Workspace:       w,
+			PaginationParam: api.AllPages(),
+		})
+		users = len(us)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return map[cache.Entity]int{
+		cache.Projects: projects,
+		cache.Clients:  clients,
+		cache.Tags:     tags,
+		cache.Users:    users,
+	}, nil
+}
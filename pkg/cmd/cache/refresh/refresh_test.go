@@ -0,0 +1,112 @@
+package refresh_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/refresh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdRefresh_Disabled(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+	cmd := refresh.NewCmdRefresh(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err,
+		"cache is disabled, set \"cache-ttl\" to enable it")
+}
+
+func TestCmdRefresh(t *testing.T) {
+	s := cache.NewStore(t.TempDir(), time.Hour)
+
+	key := func(p interface{}) string {
+		b, _ := json.Marshal(p)
+		return string(b)
+	}
+
+	active := false
+	allTags := api.GetTagsParam{
+		Workspace: "w", PaginationParam: api.AllPages()}
+	activeTags := api.GetTagsParam{
+		Workspace: "w", Archived: &active, PaginationParam: api.AllPages()}
+
+	// stale entries must be removed, even the ones not loaded again
+	assert.NoError(t, s.Set("w", cache.Tags, key(allTags),
+		[]dto.Tag{{ID: "t0", Name: "Removed"}}))
+	assert.NoError(t, s.Set("w", cache.Tasks, "tasks",
+		[]dto.Task{{ID: "tk0"}}))
+	assert.NoError(t, s.Set("w2", cache.Tags, "other",
+		[]dto.Tag{{ID: "t9"}}))
+
+	c := mocks.NewMockClient(t)
+	c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
+	c.EXPECT().GetProjects(mock.Anything).
+		Return([]dto.Project{{ID: "p1"}, {ID: "p2"}}, nil).Twice()
+	c.EXPECT().GetClients(mock.Anything).
+		Return([]dto.Client{{ID: "c1"}}, nil).Twice()
+	c.EXPECT().GetTags(allTags).
+		Return([]dto.Tag{{ID: "t1"}, {ID: "t2", Archived: true}}, nil).Once()
+	c.EXPECT().GetTags(activeTags).
+		Return([]dto.Tag{{ID: "t1"}}, nil).Once()
+	c.EXPECT().WorkspaceUsers(mock.Anything).
+		Return([]dto.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}}, nil).Once()
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().Config().
+		Return(&mocks.SimpleConfig{CacheTTLDuration: time.Hour})
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().Cache().Return(s, nil)
+	f.EXPECT().Client().Return(cache.NewClient(c, s), nil)
+
+	cmd := refresh.NewCmdRefresh(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{})
+
+	b := &bytes.Buffer{}
+	cmd.SetOut(b)
+	cmd.SetErr(b)
+
+	_, err := cmd.ExecuteC()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t,
+		"2 projects, 1 clients, 2 tags and 3 users cached\n", b.String())
+
+	var tags []dto.Tag
+	ok, err := s.Get("w", cache.Tags, key(allTags), &tags)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []dto.Tag{{ID: "t1"}, {ID: "t2", Archived: true}}, tags)
+
+	ok, err = s.Get("w", cache.Tags, key(activeTags), &tags)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []dto.Tag{{ID: "t1"}}, tags)
+
+	var tasks []dto.Task
+	ok, err = s.Get("w", cache.Tasks, "tasks", &tasks)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = s.Get("w2", cache.Tags, "other", &tags)
+	assert.NoError(t, err)
+	assert.True(t, ok, "other workspaces should be kept")
+}
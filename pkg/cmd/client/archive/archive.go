@@ -0,0 +1,45 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdArchive archives clients of a workspace
+func NewCmdArchive(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:  "archive <client>...",
+		Args: cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Archive clients on Clockify",
+		Long: "Archive clients on Clockify, similar to doing " +
+			"`client edit <client> --archived`",
+		Example: heredoc.Docf(`
+			$ %[1]s "client 2"
+			+--------------------------+----------+----------+
+			|            ID            |   NAME   | ARCHIVED |
+			+--------------------------+----------+----------+
+			| 62964b36bb48532a70730dbe | Client 2 | YES      |
+			+--------------------------+----------+----------+
+		`, "clockify-cli client archive"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return util.ChangeArchived(cmd, f, of, args, true, report)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
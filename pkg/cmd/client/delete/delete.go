@@ -0,0 +1,146 @@
+package del
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var force bool
+	cmd := &cobra.Command{
+		Use:     "delete <client>...",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Deletes clients from a Clockify workspace",
+		Long: heredoc.Doc(`
+			Deletes clients from a Clockify workspace
+			This action can't be reverted, and projects of the clients will be left without one.
+
+			Clients with active projects will not be deleted, unless the flag --force is used.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s "client 2"
+			+--------------------------+----------+----------+
+			|            ID            |   NAME   | ARCHIVED |
+			+--------------------------+----------+----------+
+			| 62964b36bb48532a70730dbe | Client 2 | YES      |
+			+--------------------------+----------+----------+
+
+			$ %[1]s "client 1"
+			client "Client 1" (6202634a28782767054eec26) still has 2 active projects, use --force to delete it anyway
+
+			$ %[1]s "client 1" --force -q
+			6202634a28782767054eec26
+		`, "clockify-cli client delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids, err := util.LookupIDs(f, c, w, args)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				if err := checkActiveProjects(c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			g, ctx := errgroup.WithContext(cmd.Context())
+			gc := c.WithContext(ctx)
+			clients := make([]dto.Client, len(ids))
+			for i := range ids {
+				j := i
+				g.Go(func() (err error) {
+					clients[j], err = gc.DeleteClient(api.DeleteClientParam{
+						Workspace: w,
+						ClientID:  ids[j],
+					})
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, clients)
+			}
+
+			return util.Report(clients, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "F", false,
+		"delete the clients even if they have active projects")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+func checkActiveProjects(c api.Client, w string, ids []string) error {
+	archived := false
+	ps, err := c.GetProjects(api.GetProjectsParam{
+		Workspace:       w,
+		Clients:         ids,
+		Archived:        &archived,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		count := 0
+		name := ""
+		for _, p := range ps {
+			if p.ClientID == id {
+				count++
+				name = p.ClientName
+			}
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		return fmt.Errorf(
+			"client \"%s\" (%s) still has %d active projects, "+
+				"use --force to delete it anyway",
+			name, id, count,
+		)
+	}
+
+	return nil
+}
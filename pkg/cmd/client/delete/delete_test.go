@@ -0,0 +1,206 @@
+package del_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/client/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Client) error
+
+func TestCmdDelete(t *testing.T) {
+	bFalse := false
+	clients := []dto.Client{
+		{ID: "c1", Name: "Client 1"},
+		{ID: "c2", Name: "Client 2"},
+	}
+
+	factory := func(t *testing.T, nameForID bool) (
+		*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("GetWorkspaceID").Return("w", nil)
+
+		cf := mocks.NewMockConfig(t)
+		f.On("Config").Return(cf)
+		cf.On("IsAllowNameForID").Return(nameForID)
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+		c.On("WithContext", mock.Anything).Return(c).Maybe()
+
+		if nameForID {
+			c.On("GetClients", api.GetClientsParam{
+				Workspace:       "w",
+				PaginationParam: api.AllPages(),
+			}).Return(clients, nil)
+		}
+
+		return f, c
+	}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "client is required",
+			err:  "requires arg client",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j", "c1"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "workspace error",
+			args: []string{"c1"},
+			err:  "w error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("", errors.New("w error"))
+				return f, nil
+			},
+		},
+		{
+			name: "client lookup error",
+			args: []string{"other"},
+			err:  "No client with id or name containing.*other",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f, _ := factory(t, true)
+				return f, nil
+			},
+		},
+		{
+			name: "client with active projects",
+			args: []string{"client 1", "client 2"},
+			err: `client "Client 2" \(c2\) still has 2 active projects, ` +
+				`use --force`,
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f, c := factory(t, true)
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					Clients:         []string{"c1", "c2"},
+					Archived:        &bFalse,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{
+					{ID: "p1", ClientID: "c2", ClientName: "Client 2"},
+					{ID: "p2", ClientID: "c2", ClientName: "Client 2"},
+				}, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"c1"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f, c := factory(t, false)
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					Clients:         []string{"c1"},
+					Archived:        &bFalse,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{}, nil)
+
+				c.On("DeleteClient", api.DeleteClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+				}).Return(dto.Client{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "deletes clients without projects",
+			args: []string{"client 1", "client 2"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f, c := factory(t, true)
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					Clients:         []string{"c1", "c2"},
+					Archived:        &bFalse,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{}, nil)
+
+				for _, cl := range clients {
+					c.On("DeleteClient", api.DeleteClientParam{
+						Workspace: "w",
+						ClientID:  cl.ID,
+					}).Return(cl, nil)
+				}
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+					called = true
+					assert.Equal(t, clients, cs)
+					return nil
+				}
+			},
+		},
+		{
+			name: "force delete does not check projects",
+			args: []string{"c1", "--force"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f, c := factory(t, false)
+				c.On("DeleteClient", api.DeleteClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+				}).Return(clients[0], nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+					called = true
+					assert.Equal(t, clients[0:1], cs)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Client) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := del.NewCmdDelete(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package edit
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdEdit updates clients
+func NewCmdEdit(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "edit <client>...",
+		Aliases: []string{"update"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Edit a client",
+		Example: heredoc.Docf(`
+			# rename a client
+			$ %[1]s "client 1" --name "First Client"
+			+--------------------------+--------------+----------+
+			|            ID            |     NAME     | ARCHIVED |
+			+--------------------------+--------------+----------+
+			| 6202634a28782767054eec26 | First Client | NO       |
+			+--------------------------+--------------+----------+
+
+			# change address and note
+			$ %[1]s first --address "Somewhere St, 42" \
+				--note "$(cat notes.txt)" \
+				--format 'n: {{.Name}}\na: {{.Address}}\nn: {{.Note}}'
+			n: First Client
+			a: Somewhere St, 42
+			n: pays on the 5th
+
+			# archive multiple clients
+			$ %[1]s first second --archived \
+				--format "{{.Name}} | {{.Archived}}"
+			First Client | true
+			Client 2 | true
+		`, "clockify-cli client edit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "archived", "active"); err != nil {
+				return err
+			}
+
+			if len(args) > 1 && cmd.Flags().Changed("name") {
+				return errors.New(
+					"`--name` can't be changed for multiple clients")
+			}
+
+			p := api.UpdateClientParam{}
+			p.Name, _ = cmd.Flags().GetString("name")
+			p.Name = strings.TrimSpace(p.Name)
+			if cmd.Flags().Changed("name") && p.Name == "" {
+				return errors.New("client name should not be empty")
+			}
+
+			if cmd.Flags().Changed("note") {
+				n, _ := cmd.Flags().GetString("note")
+				p.Note = &n
+			}
+
+			if cmd.Flags().Changed("address") {
+				a, _ := cmd.Flags().GetString("address")
+				p.Address = &a
+			}
+
+			if cmd.Flags().Changed("archived") ||
+				cmd.Flags().Changed("active") {
+				b, _ := cmd.Flags().GetBool("archived")
+				p.Archived = &b
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids, err := util.LookupIDs(f, c, p.Workspace, args)
+			if err != nil {
+				return err
+			}
+
+			clients, err := util.UpdateClients(cmd.Context(), c, p, ids)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, clients)
+			}
+
+			return util.Report(clients, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "new name of the client")
+	cmd.Flags().StringP("note", "N", "", "note for the clients")
+	cmd.Flags().String("address", "", "address of the clients")
+
+	cmd.Flags().BoolP("archived", "A", false, "set the clients as archived")
+	cmd.Flags().BoolP("active", "a", false, "set the clients as active")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
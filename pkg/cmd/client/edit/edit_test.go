@@ -0,0 +1,168 @@
+package edit_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Client) error
+
+func TestCmdEdit(t *testing.T) {
+	bTrue := true
+	note := ""
+	address := "Somewhere St, 42"
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "client is required",
+			err:  "requires arg client",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "active or archived",
+			args: []string{"--active", "--archived", "c1"},
+			err:  "flags can't be used together.*active.*archived",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "can only change the name of one client",
+			args: []string{"c1", "c2", "-n=wrong"},
+			err:  "`--name` can't be changed for multiple clients",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "name should not be empty",
+			args: []string{"c1", "--name", " "},
+			err:  "client name should not be empty",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "client should not be empty",
+			args: []string{" ", "--archived"},
+			err:  "client id/name should not be empty",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("Client").Return(mocks.NewMockClient(t), nil)
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"c1", "--archived"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("UpdateClient", api.UpdateClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+					Archived:  &bTrue,
+				}).Return(dto.Client{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "change all by name",
+			args: []string{"client 1", "-n=First", "--note=",
+				"--address", address, "--archived"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetClients", api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Client{{ID: "c1", Name: "Client 1"}}, nil)
+
+				r := dto.Client{
+					ID:       "c1",
+					Name:     "First",
+					Address:  address,
+					Archived: true,
+				}
+				c.On("UpdateClient", api.UpdateClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+					Name:      "First",
+					Note:      &note,
+					Address:   &address,
+					Archived:  &bTrue,
+				}).Return(r, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+					called = true
+					assert.Equal(t, []dto.Client{r}, cs)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Client) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := edit.NewCmdEdit(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
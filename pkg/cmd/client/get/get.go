@@ -0,0 +1,87 @@
+package get
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGet looks for a client with the informed ID
+func NewCmdGet(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	p := api.GetClientParam{}
+	cmd := &cobra.Command{
+		Use:  "get <client>",
+		Args: cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Get a client on a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s 6202634a28782767054eec26
+			+--------------------------+----------+----------+
+			|            ID            |   NAME   | ARCHIVED |
+			+--------------------------+----------+----------+
+			| 6202634a28782767054eec26 | Client 1 | NO       |
+			+--------------------------+----------+----------+
+
+			$ %[1]s "client 1" -q
+			6202634a28782767054eec26
+
+			$ %[1]s "client 1" --format '{{.Name}} | {{.Address}}'
+			Client 1 | Somewhere St, 42
+		`, "clockify-cli client get"),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if p.ClientID = strings.TrimSpace(args[0]); p.ClientID == "" {
+				return errors.New("client id should not be empty")
+			}
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if p.ClientID, err = search.GetClientByName(
+					c, p.Workspace, p.ClientID); err != nil {
+					return err
+				}
+			}
+
+			client, err := c.GetClient(p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, client)
+			}
+
+			return util.ReportOne(client, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,145 @@
+package get_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/get"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, dto.Client) error
+
+func TestCmdGet(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "client is required",
+			err:  "requires arg client",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "client should not be empty",
+			args: []string{" "},
+			err:  "client id should not be empty",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "client lookup error",
+			args: []string{"other"},
+			err:  "No client with id or name containing.*other",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetClients", api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Client{}, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"c1"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetClient", api.GetClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+				}).Return(dto.Client{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "get by name",
+			args: []string{"client 1"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				cl := dto.Client{ID: "c1", Name: "Client 1"}
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetClients", api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Client{cl}, nil)
+
+				c.On("GetClient", api.GetClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+				}).Return(cl, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, r dto.Client) error {
+					called = true
+					assert.Equal(t, cl, r)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, dto.Client) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := get.NewCmdGet(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package unarchive
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdUnarchive activates archived clients
+func NewCmdUnarchive(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "unarchive <client>...",
+		Aliases: []string{"activate"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Activate archived clients on Clockify",
+		Long: "Activate archived clients on Clockify, similar to doing " +
+			"`client edit <client> --active`",
+		Example: heredoc.Docf(`
+			$ %[1]s 62964b36bb48532a70730dbe
+			+--------------------------+----------+----------+
+			|            ID            |   NAME   | ARCHIVED |
+			+--------------------------+----------+----------+
+			| 62964b36bb48532a70730dbe | Client 2 | NO       |
+			+--------------------------+----------+----------+
+		`, "clockify-cli client unarchive"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return util.ChangeArchived(cmd, f, of, args, false, report)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
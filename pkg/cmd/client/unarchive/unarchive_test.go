@@ -0,0 +1,58 @@
+package unarchive_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/unarchive"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdUnarchive(t *testing.T) {
+	bFalse := false
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	f.On("Config").Return(cf)
+	cf.On("IsAllowNameForID").Return(true)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+	c.On("WithContext", mock.Anything).Return(c).Maybe()
+	c.On("GetClients", api.GetClientsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Client{
+		{ID: "c1", Name: "Client 1", Archived: true},
+		{ID: "c2", Name: "Client 2"},
+	}, nil)
+
+	// without a name the client keeps its current one
+	r := dto.Client{ID: "c1", Name: "Client 1"}
+	c.On("UpdateClient", api.UpdateClientParam{
+		Workspace: "w",
+		ClientID:  "c1",
+		Archived:  &bFalse,
+	}).Return(r, nil)
+
+	called := false
+	cmd := unarchive.NewCmdUnarchive(f, func(
+		_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+		called = true
+		assert.Equal(t, []dto.Client{r}, cs)
+		return nil
+	})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"client 1"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
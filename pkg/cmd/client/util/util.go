@@ -1,12 +1,19 @@
 package util
 
 import (
+	"context"
+	"errors"
 	"io"
+	"strings"
 
+	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/client"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 // OutputFlags sets how to print out a list of clients
@@ -50,3 +57,95 @@ func Report(cs []dto.Client, out io.Writer, of OutputFlags) error {
 		return output.ClientPrint(cs, out)
 	}
 }
+
+// ReportOne prints out a single client
+func ReportOne(c dto.Client, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.ClientJSONPrint(c, out)
+	default:
+		return Report([]dto.Client{c}, out, of)
+	}
+}
+
+// LookupIDs trims the client references and, if allowed, find the ids of
+// the clients using their names
+func LookupIDs(
+	f cmdutil.Factory, c api.Client, workspace string, args []string,
+) ([]string, error) {
+	ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+	if strhlp.Search("", ids) != -1 {
+		return ids, errors.New("client id/name should not be empty")
+	}
+
+	if !f.Config().IsAllowNameForID() {
+		return ids, nil
+	}
+
+	return search.GetClientsByName(c, workspace, ids)
+}
+
+// UpdateClients applies the same changes to each one of the clients
+func UpdateClients(
+	ctx context.Context,
+	c api.Client, p api.UpdateClientParam, ids []string,
+) ([]dto.Client, error) {
+	g, ctx := errgroup.WithContext(ctx)
+	gc := c.WithContext(ctx)
+	clients := make([]dto.Client, len(ids))
+	for i := range ids {
+		j := i
+		g.Go(func() (err error) {
+			cp := p
+			cp.ClientID = ids[j]
+			clients[j], err = gc.UpdateClient(cp)
+			return err
+		})
+	}
+
+	return clients, g.Wait()
+}
+
+// ChangeArchived archives or activates the clients informed as arguments,
+// used by the archive and unarchive commands
+func ChangeArchived(
+	cmd *cobra.Command,
+	f cmdutil.Factory,
+	of OutputFlags,
+	args []string,
+	archived bool,
+	report func(io.Writer, *OutputFlags, []dto.Client) error,
+) error {
+	if err := of.Check(); err != nil {
+		return err
+	}
+
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	ids, err := LookupIDs(f, c, w, args)
+	if err != nil {
+		return err
+	}
+
+	clients, err := UpdateClients(cmd.Context(), c, api.UpdateClientParam{
+		Workspace: w,
+		Archived:  &archived,
+	}, ids)
+	if err != nil {
+		return err
+	}
+
+	if report != nil {
+		return report(cmd.OutOrStdout(), &of, clients)
+	}
+
+	return Report(clients, cmd.OutOrStdout(), of)
+}
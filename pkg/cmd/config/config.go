@@ -40,6 +40,34 @@ var validParameters = cmdcompl.ValidArgsMap{
 		"formatting",
 	cmdutil.CONF_TIMEZONE: "which timezone to use to input/output time",
 	cmdutil.CONF_API_URL:  "custom Clockify API base URL (for segregated tenants)",
+	cmdutil.CONF_CACHE_TTL: "for how long projects, tasks, tags, clients " +
+		"and users are kept on the local cache (like 30m or 2h), the cache " +
+		"is disabled if not set or 0",
+	cmdutil.CONF_OFFLINE_JOURNAL: "should save changes to time entries to " +
+		"be synced later when the API is unreachable",
+	cmdutil.CONF_RETRY_ATTEMPTS: "how many times a request that failed " +
+		"because of network errors or the API being unavailable is retried " +
+		"(disabled if not set or 0)",
+	cmdutil.CONF_RETRY_MAX_DELAY: "longest wait between retries of a " +
+		"request (like 10s or 1m)",
+	cmdutil.CONF_DAILY_HOURS: "how long you are expected to work on each " +
+		"of the workweek days (like 8h or 7h30m), other hours can be set " +
+		"for some weekdays after a comma (like 8h,friday=4h,saturday=2h)",
+	cmdutil.CONF_POMODORO_BREAK_PROJECT: "project used to track the " +
+		"breaks of the pomodoro command, if empty they are not tracked",
+	cmdutil.CONF_POMODORO_NOTIFY: "command run when a focus or break of " +
+		"the pomodoro command ends (like notify-send " +
+		"\"$CLOCKIFY_POMODORO_MESSAGE\")",
+	cmdutil.CONF_IDLE_THRESHOLD: "how long a time entry can run before " +
+		"\"out\" considers it forgotten (like 10h), empty disables it",
+	cmdutil.CONF_WORKDAY_END: "time of the day your workday ends (like " +
+		"18:00), \"out\" considers time entries running since before it " +
+		"forgotten",
+	cmdutil.CONF_BALANCE_START: "first day (like 2026-01-01) used to " +
+		"calculate the overtime balance of the \"balance\" command",
+	cmdutil.CONF_STATUS_TTL: "for how long the time entry running is " +
+		"shown by the \"status\" command without checking it on the API " +
+		"(like 1m or 10m, 0 always checks it)",
 }
 
 // NewCmdConfig represents the config command
@@ -0,0 +1,143 @@
+package dashboard
+
+import (
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// actions changes the time entries of the user using the same steps as the
+// time entry commands
+type actions struct {
+	f cmdutil.Factory
+	c api.Client
+	w string
+	u string
+}
+
+// start asks for the properties of a new time entry and starts it, stopping
+// the running one
+func (a actions) start() error {
+	dc := util.NewDescriptionCompleter(a.f)
+	_, err := util.Do(
+		util.TimeEntryDTO{
+			Workspace: a.w,
+			UserID:    a.u,
+			Start:     timehlp.Now(),
+		},
+		util.ValidateClosingTimeEntry(a.f),
+		util.GetPropsInteractiveFn(dc, a.f),
+		util.GetDatesInteractiveFn(a.f),
+		util.FillMissingBillableFn(a.c),
+		util.GetValidateTimeEntryFn(a.f),
+		util.OutInProgressFn(a.c),
+		util.CreateTimeEntryFn(a.c),
+	)
+
+	return err
+}
+
+// out stops the running time entry now
+func (a actions) out() error {
+	_, err := util.Do(
+		util.TimeEntryDTO{
+			Workspace: a.w,
+			UserID:    a.u,
+			Start:     timehlp.Now(),
+		},
+		util.ValidateClosingTimeEntry(a.f),
+		util.OutInProgressFn(a.c),
+	)
+
+	return err
+}
+
+// clone starts a copy of the time entry, id can be a alias of
+// timeentryhlp
+func (a actions) clone(id string) error {
+	tec, err := timeentryhlp.GetTimeEntry(a.c, a.w, a.u, id)
+	if err != nil {
+		return err
+	}
+
+	tec.UserID = a.u
+	tec.TimeInterval = dto.NewTimeInterval(timehlp.Now(), nil)
+
+	dc := util.NewDescriptionCompleter(a.f)
+	_, err = util.Do(
+		util.TimeEntryImplToDTO(tec),
+		util.ValidateClosingTimeEntry(a.f),
+		util.GetPropsInteractiveFn(dc, a.f),
+		util.GetDatesInteractiveFn(a.f),
+		util.GetValidateTimeEntryFn(a.f),
+		util.OutInProgressFn(a.c),
+		util.CreateTimeEntryFn(a.c),
+	)
+
+	return err
+}
+
+// edit asks for new values to the time entry, id can be a alias of
+// timeentryhlp
+func (a actions) edit(id string) error {
+	t, err := timeentryhlp.GetTimeEntry(a.c, a.w, a.u, id)
+	if err != nil {
+		return err
+	}
+
+	dc := util.NewDescriptionCompleter(a.f)
+	te, err := util.Do(
+		util.TimeEntryImplToDTO(t),
+		util.GetPropsInteractiveFn(dc, a.f),
+		util.GetDatesInteractiveFn(a.f),
+		util.GetValidateTimeEntryFn(a.f),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace:   te.Workspace,
+		TimeEntryID: te.ID,
+		Description: te.Description,
+		Start:       te.Start,
+		End:         te.End,
+		Billable:    *te.Billable,
+		ProjectID:   te.ProjectID,
+		TaskID:      te.TaskID,
+		TagIDs:      te.TagIDs,
+	})
+
+	return err
+}
+
+// delete removes the time entry after the user confirms it, id can be a
+// alias of timeentryhlp
+func (a actions) delete(id string) error {
+	t, err := timeentryhlp.GetTimeEntry(a.c, a.w, a.u, id)
+	if err != nil {
+		return err
+	}
+
+	desc := t.Description
+	if desc == "" {
+		desc = t.ID
+	}
+
+	ok, err := a.f.UI().Confirm(
+		"Delete time entry \""+desc+"\" started at "+
+			t.TimeInterval.Start.Local().Format(timehlp.FullTimeFormat)+"?",
+		false,
+	)
+	if err != nil || !ok {
+		return err
+	}
+
+	return a.c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace:   a.w,
+		TimeEntryID: t.ID,
+	})
+}
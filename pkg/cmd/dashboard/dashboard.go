@@ -0,0 +1,289 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// NewCmdDashboard represents the ui command
+func NewCmdDashboard(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ui",
+		Aliases: []string{"dashboard"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "Shows a full screen dashboard of your time entries",
+		Long: heredoc.Doc(`
+			Shows a full screen dashboard with the running time entry, the time entries of today and the totals of the week against the hours expected on it (set with the configs "workweek-days" and "daily-hours"), with the work week days marked with "*".
+
+			The dashboard is updated every second, and the following keys can be used:
+			  s          start a new time entry, stopping the running one
+			  o          stop the running time entry
+			  c          clone the selected time entry (or the last one)
+			  e          edit the selected time entry (or the last one)
+			  d          delete the selected time entry (or the last one)
+			  ↑/↓ or j/k change which time entry of today is selected
+			  r          reload the time entries
+			  q          quit
+
+			Starting, cloning and editing time entries will ask their properties the same way as the commands "in", "clone" and "edit" do in interactive mode.
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !f.Config().IsInteractive() {
+				return errors.New("the dashboard can't be used with " +
+					"interactive mode disabled")
+			}
+
+			in, ok := cmd.InOrStdin().(ui.FileReader)
+			if !ok || !term.IsTerminal(int(in.Fd())) {
+				return errors.New("the dashboard needs a terminal as input")
+			}
+
+			out, ok := cmd.OutOrStdout().(ui.FileWriter)
+			if !ok || !term.IsTerminal(int(out.Fd())) {
+				return errors.New("the dashboard needs a terminal as output")
+			}
+
+			w, err := f.GetWorkspace()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			d := &dashboard{
+				in:  in,
+				out: out,
+				load: func() (view, error) {
+					return load(c, w, u, f.Config().DailyHoursOn,
+						timehlp.Today())
+				},
+				actions: actions{f: f, c: c, w: w.ID, u: u},
+			}
+
+			return d.run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+type dashboard struct {
+	in      ui.FileReader
+	out     ui.FileWriter
+	state   *term.State
+	load    func() (view, error)
+	actions actions
+
+	view     view
+	selected int
+	message  string
+}
+
+// enter changes the terminal to a alternate screen and reads the keys
+// without waiting for a line
+func (d *dashboard) enter() error {
+	s, err := term.MakeRaw(int(d.in.Fd()))
+	if err != nil {
+		return err
+	}
+
+	d.state = s
+	_, err = fmt.Fprint(d.out, "\x1b[?1049h\x1b[?25l")
+	return err
+}
+
+// leave restores the terminal to how it was before entering the dashboard
+func (d *dashboard) leave() {
+	_, _ = fmt.Fprint(d.out, "\x1b[2J\x1b[H\x1b[?25h\x1b[?1049l")
+	if d.state != nil {
+		_ = term.Restore(int(d.in.Fd()), d.state)
+		d.state = nil
+	}
+}
+
+func (d *dashboard) draw() {
+	width, height, err := term.GetSize(int(d.out.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	lines := d.view.render(timehlp.Now(), d.selected, d.message, width)
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+
+	_, _ = fmt.Fprint(d.out, "\x1b[H\x1b[2J"+strings.Join(lines, "\r\n"))
+}
+
+func (d *dashboard) reload() {
+	v, err := d.load()
+	if err != nil {
+		d.message = "failed to load time entries: " + err.Error()
+		return
+	}
+
+	d.view = v
+	if n := len(v.today(timehlp.Now())); d.selected >= n {
+		d.selected = max(0, n-1)
+	}
+}
+
+// selectedID returns the id of the selected time entry, or the alias for the
+// last one if there are no time entries today
+func (d *dashboard) selectedID() string {
+	today := d.view.today(timehlp.Now())
+	if d.selected < len(today) {
+		return today[d.selected].ID
+	}
+
+	return timeentryhlp.AliasLatest
+}
+
+// readKey reads a key press, translating arrows
+func readKey(r io.Reader) (string, error) {
+	b := make([]byte, 8)
+	n, err := r.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	switch k := string(b[:n]); k {
+	case "\x1b[A", "\x1bOA":
+		return "k", nil
+	case "\x1b[B", "\x1bOB":
+		return "j", nil
+	case "\x03", "\x04":
+		return "q", nil
+	default:
+		return k, nil
+	}
+}
+
+// interact leaves the dashboard to run a action that prompts the user,
+// reloading the time entries after it
+func (d *dashboard) interact(fn func() error) error {
+	d.leave()
+	err := fn()
+	if e := d.enter(); e != nil {
+		return e
+	}
+
+	d.message = ""
+	if errors.Is(err, terminal.InterruptErr) {
+		d.message = "cancelled"
+	} else if err != nil {
+		d.message = err.Error()
+	}
+
+	d.reload()
+	return nil
+}
+
+func (d *dashboard) run(ctx context.Context) error {
+	if err := d.enter(); err != nil {
+		return err
+	}
+	defer d.leave()
+
+	d.message = "loading..."
+	d.draw()
+	d.message = ""
+	d.reload()
+	d.draw()
+
+	type key struct {
+		k   string
+		err error
+	}
+
+	// keys are only read when asked, so prompts can read the input while
+	// running actions
+	next := make(chan struct{})
+	keys := make(chan key)
+	go func() {
+		for range next {
+			k, err := readKey(d.in)
+			keys <- key{k: k, err: err}
+		}
+	}()
+	defer close(next)
+
+	next <- struct{}{}
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		case k := <-keys:
+			if k.err != nil {
+				return k.err
+			}
+
+			var err error
+			switch k.k {
+			case "q", "Q":
+				return nil
+			case "j":
+				if d.selected < len(d.view.today(timehlp.Now()))-1 {
+					d.selected++
+				}
+			case "k":
+				if d.selected > 0 {
+					d.selected--
+				}
+			case "r":
+				d.message = ""
+				d.reload()
+			case "o":
+				d.message = ""
+				if err := d.actions.out(); err != nil {
+					d.message = err.Error()
+				}
+				d.reload()
+			case "s":
+				err = d.interact(d.actions.start)
+			case "c":
+				id := d.selectedID()
+				err = d.interact(func() error { return d.actions.clone(id) })
+			case "e":
+				id := d.selectedID()
+				err = d.interact(func() error { return d.actions.edit(id) })
+			case "d":
+				id := d.selectedID()
+				err = d.interact(func() error { return d.actions.delete(id) })
+			}
+
+			if err != nil {
+				return err
+			}
+
+			next <- struct{}{}
+		}
+
+		d.draw()
+	}
+}
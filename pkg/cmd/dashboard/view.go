@@ -0,0 +1,228 @@
+package dashboard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// view holds the time entries of the week being shown on the dashboard
+type view struct {
+	workspace string
+	// dailyHours is how long the user is expected to work on each weekday
+	dailyHours func(time.Weekday) time.Duration
+
+	// entries of the week, most recent first
+	entries []dto.TimeEntry
+}
+
+// load fetches the time entries of the user on the week of today
+func load(
+	c api.Client, w dto.Workspace, userID string,
+	dailyHours func(time.Weekday) time.Duration, today time.Time,
+) (view, error) {
+	first, last := timehlp.GetWeekRange(today)
+	tes, err := c.LogRange(api.LogRangeParam{
+		Workspace:       w.ID,
+		UserID:          userID,
+		FirstDate:       first,
+		LastDate:        last.AddDate(0, 0, 1),
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return view{}, err
+	}
+
+	sort.Slice(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.After(tes[j].TimeInterval.Start)
+	})
+
+	return view{
+		workspace:  w.Name,
+		dailyHours: dailyHours,
+		entries:    tes,
+	}, nil
+}
+
+func duration(te dto.TimeEntry, now time.Time) time.Duration {
+	end := now
+	if te.TimeInterval.End != nil {
+		end = *te.TimeInterval.End
+	}
+
+	return end.Sub(te.TimeInterval.Start)
+}
+
+func durationToString(d time.Duration) string {
+	return dto.Duration{Duration: d}.HumanString()
+}
+
+// running returns the time entry without end, if there is one
+func (v view) running() *dto.TimeEntry {
+	for i := range v.entries {
+		if v.entries[i].TimeInterval.End == nil {
+			return &v.entries[i]
+		}
+	}
+
+	return nil
+}
+
+// today returns the time entries started on the same day as now
+func (v view) today(now time.Time) []dto.TimeEntry {
+	day := timehlp.TruncateDate(now)
+	tes := make([]dto.TimeEntry, 0)
+	for _, te := range v.entries {
+		if timehlp.TruncateDate(te.TimeInterval.Start.In(now.Location())).
+			Equal(day) {
+			tes = append(tes, te)
+		}
+	}
+
+	return tes
+}
+
+// weekTotals sums the duration of the time entries by weekday, starting on
+// the first day of the week
+func (v view) weekTotals(now time.Time) [7]time.Duration {
+	var totals [7]time.Duration
+	for _, te := range v.entries {
+		d := int(te.TimeInterval.Start.In(now.Location()).Weekday())
+		totals[d] += duration(te, now)
+	}
+
+	return totals
+}
+
+func (v view) isWorkday(d time.Weekday) bool {
+	return v.dailyHours(d) > 0
+}
+
+func describe(te dto.TimeEntry) string {
+	s := make([]string, 0, 3)
+	if te.Project != nil {
+		s = append(s, te.Project.Name)
+	}
+
+	if te.Task != nil {
+		s = append(s, te.Task.Name)
+	}
+
+	d := te.Description
+	if d == "" {
+		d = "(no description)"
+	}
+
+	if len(s) == 0 {
+		return d
+	}
+
+	return strings.Join(s, " / ") + " - " + d
+}
+
+// truncate limits the line to the width of the terminal
+func truncate(s string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+
+	return string([]rune(s)[:width])
+}
+
+const help = "[s]tart  [o]ut  [c]lone  [e]dit  [d]elete  [r]efresh  [q]uit" +
+	"  (↑/↓ or j/k to select)"
+
+// render builds the lines of the dashboard for the moment informed
+func (v view) render(
+	now time.Time, selected int, message string, width int,
+) []string {
+	lines := make([]string, 0)
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, truncate(fmt.Sprintf(format, args...), width))
+	}
+
+	add("%s  %s", now.Format("Mon, 02 Jan 2006 15:04:05"), v.workspace)
+	add("")
+
+	if te := v.running(); te != nil {
+		add("RUNNING  %s  since %s  %s",
+			durationToString(duration(*te, now)),
+			te.TimeInterval.Start.In(now.Location()).
+				Format(timehlp.OnlyTimeFormat),
+			describe(*te),
+		)
+	} else {
+		add("No time entry running")
+	}
+	add("")
+
+	today := v.today(now)
+	var total time.Duration
+	for _, te := range today {
+		total += duration(te, now)
+	}
+
+	add("TODAY  %s", durationToString(total))
+	if len(today) == 0 {
+		add("  no time entries")
+	}
+
+	for i, te := range today {
+		cursor := " "
+		if i == selected {
+			cursor = ">"
+		}
+
+		end := "now     "
+		if te.TimeInterval.End != nil {
+			end = te.TimeInterval.End.In(now.Location()).
+				Format(timehlp.OnlyTimeFormat)
+		}
+
+		add("%s %s - %s  %s  %s",
+			cursor,
+			te.TimeInterval.Start.In(now.Location()).
+				Format(timehlp.OnlyTimeFormat),
+			end,
+			durationToString(duration(te, now)),
+			describe(te),
+		)
+	}
+	add("")
+
+	totals := v.weekTotals(now)
+	days := make([]string, 7)
+	values := make([]string, 7)
+	total = 0
+	var expected time.Duration
+	for i := range totals {
+		wd := time.Weekday(i)
+		days[i] = fmt.Sprintf("%8s", wd.String()[:3])
+		if v.isWorkday(wd) {
+			days[i] = fmt.Sprintf("%8s", wd.String()[:3]+"*")
+			expected += v.dailyHours(wd)
+		}
+
+		values[i] = fmt.Sprintf("%8s", durationToString(totals[i]))
+		total += totals[i]
+	}
+
+	add("WEEK  %s of %s",
+		durationToString(total), durationToString(expected))
+	add("%s", strings.Join(days, ""))
+	add("%s", strings.Join(values, ""))
+	add("")
+
+	add("%s", help)
+	if message != "" {
+		add("%s", message)
+	}
+
+	return lines
+}
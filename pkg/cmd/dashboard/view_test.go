@@ -0,0 +1,101 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadAndRender(t *testing.T) {
+	l := time.FixedZone("test", -3*60*60)
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, l) // wednesday
+	at := func(d, h, m int) time.Time {
+		return time.Date(2026, 10, d, h, m, 0, 0, l)
+	}
+	end := func(d, h, m int) *time.Time {
+		t := at(d, h, m)
+		return &t
+	}
+
+	c := mocks.NewMockClient(t)
+	c.EXPECT().LogRange(api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       time.Date(2026, 10, 11, 0, 0, 0, 0, l),
+		LastDate:        time.Date(2026, 10, 18, 0, 0, 0, 0, l),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{
+			ID:          "te1",
+			Description: "Planning",
+			TimeInterval: dto.TimeInterval{
+				Start: at(12, 9, 0), End: end(12, 17, 0)},
+		},
+		{
+			ID:          "te2",
+			Description: "Reviewing",
+			Project:     &dto.Project{Name: "CLI"},
+			TimeInterval: dto.TimeInterval{
+				Start: at(14, 9, 0), End: end(14, 12, 0)},
+		},
+		{
+			ID:      "te3",
+			Project: &dto.Project{Name: "CLI"},
+			Task:    &dto.Task{Name: "Dashboard"},
+			TimeInterval: dto.TimeInterval{
+				Start: at(14, 13, 0)},
+		},
+	}, nil)
+
+	v, err := load(c, dto.Workspace{ID: "w", Name: "Work"}, "u",
+		func(wd time.Weekday) time.Duration {
+			if wd == time.Sunday || wd == time.Saturday {
+				return 0
+			}
+			return 8 * time.Hour
+		},
+		time.Date(2026, 10, 14, 0, 0, 0, 0, l))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if r := v.running(); assert.NotNil(t, r) {
+		assert.Equal(t, "te3", r.ID)
+	}
+
+	today := v.today(now)
+	if assert.Len(t, today, 2) {
+		assert.Equal(t, "te3", today[0].ID)
+		assert.Equal(t, "te2", today[1].ID)
+	}
+
+	totals := v.weekTotals(now)
+	assert.Equal(t, 8*time.Hour, totals[time.Monday])
+	assert.Equal(t, 5*time.Hour+30*time.Minute, totals[time.Wednesday])
+
+	lines := v.render(now, 1, "saved", 200)
+	assert.Equal(t, strings.Join([]string{
+		"Wed, 14 Oct 2026 15:30:00  Work",
+		"",
+		"RUNNING  2:30:00  since 13:00:00  CLI / Dashboard - (no description)",
+		"",
+		"TODAY  5:30:00",
+		"  13:00:00 - now       2:30:00  CLI / Dashboard - (no description)",
+		"> 09:00:00 - 12:00:00  3:00:00  CLI - Reviewing",
+		"",
+		"WEEK  13:30:00 of 40:00:00",
+		"     Sun    Mon*    Tue*    Wed*    Thu*    Fri*     Sat",
+		" 0:00:00 8:00:00 0:00:00 5:30:00 0:00:00 0:00:00 0:00:00",
+		"",
+		help,
+		"saved",
+	}, "\n"), strings.Join(lines, "\n"))
+
+	lines = v.render(now, 0, "", 10)
+	assert.Equal(t, "Wed, 14 Oc", lines[0])
+}
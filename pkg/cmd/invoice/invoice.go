@@ -0,0 +1,259 @@
+package invoice
+
+import (
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	reportutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/invoice"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdInvoice represents the invoice command
+func NewCmdInvoice(f cmdutil.Factory) *cobra.Command {
+	var (
+		client       string
+		number       string
+		templateFile string
+		html         bool
+		printable    bool
+		markInvoiced bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "invoice <start> [<end>]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Creates a invoice of the billable time entries of a client",
+		Long: heredoc.Docf(`
+			Creates a invoice of the billable time entries of a client, between the dates informed (format "2006-01-02", <end> defaults to today), that are not invoiced yet.
+
+			The time entries are grouped by project, task and hourly rate, the rates following the same hierarchy of Clockify (workspace, project, membership and task), and the amounts are formatted using the config "lang".
+
+			The invoice is printed as markdown by default, or as HTML with --html, or as a self-contained HTML ready to be printed (or saved as PDF by a browser) with --printable.
+
+			A custom Go template can be used with --template, markdown templates use "text/template" and the others "html/template". The template receives a invoice with the fields:
+			  .Number, .Date, .Start, .End, .Workspace, .Client, .TimeEntries
+			  .Items: list with .ProjectName, .TaskName, .Rate, .Duration, .Hours, .Amount and .TimeEntries
+			  .Totals: list with .Currency, .Duration and .Amount
+			and the functions:
+			  money(cents int, currency string), rate(dto.Rate), hours(time.Duration),
+			  duration(time.Duration) and date(time.Time)
+
+			With --mark-invoiced, the time entries on the invoice will be marked as invoiced after it is printed.
+
+			%s
+		`, reportutil.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# invoice of the billable time entries of september for "Acme"
+			$ clockify-cli invoice 2026-09-01 2026-09-30 --client acme --number 42
+			# Invoice 42
+
+			**Date:** 2026-10-01
+			**Period:** 2026-09-01 to 2026-09-30
+
+			**From:** Your Workspace
+			**To:** Acme
+
+			| Project | Task | Hours | Rate | Amount |
+			|---------|------|------:|-----:|-------:|
+			| Website | Layout | 10.50 | USD 50.00 | USD 525.00 |
+			| Website | - | 2.00 | USD 40.00 | USD 80.00 |
+			| **Total** | | **12.50** | | **USD 605.00** |
+
+			# printable invoice, marking the time entries as invoiced
+			$ clockify-cli invoice 2026-09-01 2026-09-30 -c acme --printable --mark-invoiced > invoice.html
+
+			# using a custom template
+			$ clockify-cli invoice 2026-09-01 -c acme --html --template ~/invoice.html.tmpl
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"html":      html,
+				"printable": printable,
+			}); err != nil {
+				return err
+			}
+
+			format := invoice.FormatMarkdown
+			if html {
+				format = invoice.FormatHTML
+			} else if printable {
+				format = invoice.FormatPrintable
+			}
+
+			tmpl := ""
+			if templateFile != "" {
+				b, err := os.ReadFile(templateFile)
+				if err != nil {
+					return err
+				}
+				tmpl = string(b)
+			}
+
+			start, end, err := reportutil.ParseRangeArgs(args)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				end = timehlp.Today()
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if client, err = search.GetClientByName(
+					c, w, client); err != nil {
+					return err
+				}
+			}
+
+			cl, err := c.GetClient(api.GetClientParam{
+				Workspace: w,
+				ClientID:  client,
+			})
+			if err != nil {
+				return err
+			}
+
+			ws, err := c.GetWorkspace(api.GetWorkspace{ID: w})
+			if err != nil {
+				return err
+			}
+
+			ps, err := c.GetProjects(api.GetProjectsParam{
+				Workspace:       w,
+				Clients:         []string{cl.ID},
+				Hydrate:         true,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(ps) == 0 {
+				return errors.New("client \"" + cl.Name + "\" has no projects")
+			}
+
+			wg, ctx := errgroup.WithContext(cmd.Context())
+			gc := c.WithContext(ctx)
+			logs := make([][]dto.TimeEntry, len(ps))
+			for i := range ps {
+				i := i
+				wg.Go(func() error {
+					var err error
+					logs[i], err = gc.LogRange(api.LogRangeParam{
+						Workspace:       w,
+						UserID:          u,
+						FirstDate:       timehlp.TruncateDate(start),
+						LastDate:        timehlp.TruncateDate(end).AddDate(0, 0, 1),
+						ProjectID:       ps[i].ID,
+						PaginationParam: api.AllPages(),
+					})
+
+					return err
+				})
+			}
+
+			if err := wg.Wait(); err != nil {
+				return err
+			}
+
+			tes := uninvoiced(logs)
+			if len(tes) == 0 {
+				return errors.New("there are no billable time entries " +
+					"to invoice for client \"" + cl.Name + "\" on this period")
+			}
+
+			inv := invoice.New(tes, invoice.Options{
+				Number:    number,
+				Date:      timehlp.Now(),
+				Start:     start,
+				End:       end,
+				Workspace: ws,
+				Client:    cl,
+				Rates:     ratehlp.New(u, ws, ps),
+			})
+
+			if err := invoice.InvoicePrint(inv, format, tmpl,
+				f.Config().Language(), cmd.OutOrStdout()); err != nil {
+				return err
+			}
+
+			if !markInvoiced {
+				return nil
+			}
+
+			ids := make([]string, len(tes))
+			for i := range tes {
+				ids[i] = tes[i].ID
+			}
+
+			return c.ChangeInvoiced(api.ChangeInvoicedParam{
+				Workspace:    w,
+				TimeEntryIDs: ids,
+				Invoiced:     true,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&client, "client", "c", "",
+		"client to be invoiced")
+	_ = cmd.MarkFlagRequired("client")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "client",
+		cmdcomplutil.NewClientAutoComplete(f))
+	cmd.Flags().StringVarP(&number, "number", "n", "",
+		"number or code of the invoice")
+	cmd.Flags().StringVarP(&templateFile, "template", "t", "",
+		"file with a Go template to render the invoice")
+	cmd.Flags().BoolVar(&html, "html", false, "print the invoice as HTML")
+	cmd.Flags().BoolVar(&printable, "printable", false,
+		"print the invoice as a self-contained HTML ready to be printed")
+	cmd.Flags().BoolVar(&markInvoiced, "mark-invoiced", false,
+		"mark the time entries of the invoice as invoiced")
+
+	return cmd
+}
+
+// uninvoiced returns the finished billable time entries that are not
+// invoiced, sorted by their start
+func uninvoiced(logs [][]dto.TimeEntry) []dto.TimeEntry {
+	tes := make([]dto.TimeEntry, 0)
+	for _, l := range logs {
+		for _, te := range l {
+			if te.Billable && !te.IsInvoiced && te.TimeInterval.End != nil {
+				tes = append(tes, te)
+			}
+		}
+	}
+
+	sort.Slice(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+	})
+
+	return tes
+}
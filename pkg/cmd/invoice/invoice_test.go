@@ -0,0 +1,148 @@
+package invoice_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/text/language"
+)
+
+func TestCmdInvoice(t *testing.T) {
+	start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	usd := func(a int64) dto.Rate { return dto.Rate{Amount: a, Currency: "USD"} }
+
+	projects := []dto.Project{
+		{ID: "p1", Name: "Website", HourlyRate: usd(5000)},
+	}
+
+	te := func(id string, billable, invoiced bool) dto.TimeEntry {
+		return dto.TimeEntry{
+			ID:           id,
+			Billable:     billable,
+			IsInvoiced:   invoiced,
+			ProjectID:    "p1",
+			Project:      &projects[0],
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		}
+	}
+
+	factory := func(t *testing.T, tes []dto.TimeEntry) (
+		*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+
+		cf := mocks.NewMockConfig(t)
+		f.On("Config").Return(cf)
+		cf.On("IsAllowNameForID").Return(false)
+		cf.On("Language").Return(language.English).Maybe()
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+		c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
+
+		c.On("GetClient", api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{ID: "c1", Name: "Acme"}, nil)
+		c.On("GetWorkspace", api.GetWorkspace{ID: "w"}).
+			Return(dto.Workspace{ID: "w", Name: "Mine"}, nil)
+		c.On("GetProjects", api.GetProjectsParam{
+			Workspace:       "w",
+			Clients:         []string{"c1"},
+			Hydrate:         true,
+			PaginationParam: api.AllPages(),
+		}).Return(projects, nil)
+
+		c.On("LogRange", api.LogRangeParam{
+			Workspace:       "w",
+			UserID:          "u",
+			FirstDate:       time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			LastDate:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			ProjectID:       "p1",
+			PaginationParam: api.AllPages(),
+		}).Return(tes, nil)
+
+		return f, c
+	}
+
+	t.Run("only one format", func(t *testing.T) {
+		cmd := invoice.NewCmdInvoice(mocks.NewMockFactory(t))
+		cmd.SetArgs([]string{"2026-09-01", "-c", "c1", "--html", "--printable"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.ErrorContains(t, err,
+			"the following flags can't be used together: `html` and `printable`")
+	})
+
+	t.Run("nothing to invoice", func(t *testing.T) {
+		f, _ := factory(t, []dto.TimeEntry{
+			te("not-billable", false, false),
+			te("invoiced", true, true),
+		})
+
+		cmd := invoice.NewCmdInvoice(f)
+		cmd.SetArgs([]string{"2026-09-01", "2026-09-30", "-c", "c1"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "there are no billable time entries "+
+			"to invoice for client \"Acme\" on this period")
+	})
+
+	t.Run("marks only the invoiced time entries", func(t *testing.T) {
+		f, c := factory(t, []dto.TimeEntry{
+			te("te1", true, false),
+			te("not-billable", false, false),
+			te("invoiced", true, true),
+			te("te2", true, false),
+		})
+
+		c.On("ChangeInvoiced", api.ChangeInvoicedParam{
+			Workspace:    "w",
+			TimeEntryIDs: []string{"te1", "te2"},
+			Invoiced:     true,
+		}).Return(nil).Once()
+
+		b := &bytes.Buffer{}
+		cmd := invoice.NewCmdInvoice(f)
+		cmd.SetArgs([]string{"2026-09-01", "2026-09-30", "-c", "c1",
+			"--number", "42", "--mark-invoiced"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(b)
+
+		_, err := cmd.ExecuteC()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Contains(t, b.String(), "# Invoice 42")
+		assert.Contains(t, b.String(),
+			"| Website | - | 4.00 | USD 50.00 | USD 200.00 |")
+	})
+
+	t.Run("template file not found", func(t *testing.T) {
+		cmd := invoice.NewCmdInvoice(mocks.NewMockFactory(t))
+		cmd.SetArgs([]string{"2026-09-01", "2026-09-30", "-c", "c1",
+			"--template", "/does/not/exist", "--mark-invoiced"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
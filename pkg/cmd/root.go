@@ -1,13 +1,19 @@
 package cmd
 
 import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/completion"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/dashboard"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/invoice"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template"
 	timeentry "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry"
+	timeoff "github.com/lucassabreu/clockify-cli/pkg/cmd/time-off"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/me"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/version"
@@ -76,6 +82,13 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(tag.NewCmdTag(f))
 
 	cmd.AddCommand(timeentry.NewCmdTimeEntry(f)...)
+	cmd.AddCommand(sync.NewCmdSync(f))
+	cmd.AddCommand(dashboard.NewCmdDashboard(f))
+	cmd.AddCommand(invoice.NewCmdInvoice(f))
+	cmd.AddCommand(template.NewCmdTemplate(f))
+	cmd.AddCommand(timeoff.NewCmdTimeOff(f))
+
+	cmd.AddCommand(cache.NewCmdCache(f))
 
 	cmd.AddCommand(completion.NewCmdCompletion())
 
@@ -0,0 +1,49 @@
+package status
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/journal"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdStatus represents the sync status command
+func NewCmdStatus(f cmdutil.Factory) *cobra.Command {
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:     "status",
+		Aliases: []string{"pending"},
+		Short:   "Lists the changes to time entries waiting to be synced",
+		Example: heredoc.Doc(`
+			$ clockify-cli sync status
+			+---+---------------------+-------------------------+--------------------------+---------------------------------------------------+
+			| # |     RECORDED AT     |        OPERATION        |        TIME ENTRY        |                      DETAILS                      |
+			+---+---------------------+-------------------------+--------------------------+---------------------------------------------------+
+			| 1 | 2026-10-17 09:00:03 | stop running time entry | 62ae2744c22de9759e73d038 | stop at 2026-10-17 09:00:00                       |
+			| 2 | 2026-10-17 09:00:03 | create time entry       | offline-2                | 2026-10-17 09:00:00 until now: Writing the report |
+			+---+---------------------+-------------------------+--------------------------+---------------------------------------------------+
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			j, err := f.Journal()
+			if err != nil {
+				return err
+			}
+
+			ops, err := j.Pending()
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return output.OperationsJSONPrint(ops, cmd.OutOrStdout())
+			}
+
+			return output.OperationsPrint(ops, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as json")
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package status_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync/status"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdStatus(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 3, 0, time.Local)
+	start := time.Date(2026, 10, 17, 9, 0, 0, 0, time.Local)
+
+	tts := []struct {
+		name     string
+		ops      []journal.Operation
+		expected string
+	}{
+		{
+			name: "empty journal",
+			expected: heredoc.Doc(`
+				+---+-------------+-----------+------------+---------+
+				| # | RECORDED AT | OPERATION | TIME ENTRY | DETAILS |
+				+---+-------------+-----------+------------+---------+
+				+---+-------------+-----------+------------+---------+
+			`),
+		},
+		{
+			name: "pending changes",
+			ops: []journal.Operation{
+				{Kind: journal.KindOut, Workspace: "w", TimeEntryID: "te1",
+					Out: &api.OutParam{
+						Workspace: "w", UserID: "u", End: start}},
+				{Kind: journal.KindCreate, Workspace: "w",
+					Create: &api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       start,
+						Description: "Writing",
+					}},
+			},
+			expected: heredoc.Doc(`
+				+---+---------------------+-------------------------+------------+--------------------------------+
+				| # |     RECORDED AT     |        OPERATION        | TIME ENTRY |            DETAILS             |
+				+---+---------------------+-------------------------+------------+--------------------------------+
+				| 1 | 2026-10-17 09:00:03 | stop running time entry | te1        | stop at 2026-10-17 09:00:00    |
+				| 2 | 2026-10-17 09:00:03 | create time entry       | offline-2  | 2026-10-17 09:00:00 until now: |
+				|    |                     |                         |            | Writing                        |
+				+---+---------------------+-------------------------+------------+--------------------------------+
+			`),
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			j := journal.New(t.TempDir(),
+				journal.WithNow(func() time.Time { return now }))
+			for _, o := range tt.ops {
+				if _, err := j.Record(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().Journal().Return(j, nil)
+
+			cmd := status.NewCmdStatus(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs([]string{})
+
+			b := &bytes.Buffer{}
+			cmd.SetOut(b)
+			cmd.SetErr(b)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, b.String())
+		})
+	}
+}
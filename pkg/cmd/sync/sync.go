@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync/status"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/journal"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSync represents the sync command
+func NewCmdSync(f cmdutil.Factory) *cobra.Command {
+	var asJSON bool
+	o := journal.ReplayOptions{}
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sends the changes to time entries made while offline",
+		Long: heredoc.Doc(`
+			When the config "offline-journal" is enabled, changes made to time
+			entries (by "in", "out", "manual", "edit", "delete", etc) while the
+			API is unreachable are saved on a local journal.
+
+			This command sends them to the API in the same order they were made,
+			and stops if one of them conflicts with changes made remotely
+			meanwhile (like the time entry being edited on the site, or another
+			timer being started).
+
+			While there are changes waiting to be synced, new changes will also
+			be saved on the journal, to keep them in order.
+		`),
+		Example: heredoc.Doc(`
+			# enable the journal
+			$ clockify-cli config set offline-journal true
+
+			# see what is waiting to be synced
+			$ clockify-cli sync status
+
+			$ clockify-cli sync
+			+---+-------------------------+---------+--------------------------+--------+
+			| # |        OPERATION        | STATUS  |        TIME ENTRY        | REASON |
+			+---+-------------------------+---------+--------------------------+--------+
+			| 1 | stop running time entry | applied | 62ae2744c22de9759e73d038 |        |
+			| 2 | create time entry       | applied | 62ae27cd49445270d7bf0333 |        |
+			+---+-------------------------+---------+--------------------------+--------+
+
+			# drop the changes that conflict with the ones on the API
+			$ clockify-cli sync --discard-conflicts
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"force":             o.Force,
+				"discard-conflicts": o.DiscardConflicts,
+			}); err != nil {
+				return err
+			}
+
+			j, err := f.Journal()
+			if err != nil {
+				return err
+			}
+
+			ops, err := j.Pending()
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if len(ops) == 0 {
+				_, err := fmt.Fprintln(out, "nothing to sync")
+				return err
+			}
+
+			if o.UserID, err = f.GetUserID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			rs, rErr := j.Replay(c, o)
+			if len(rs) != 0 {
+				report := output.ResultsPrint
+				if asJSON {
+					report = output.ResultsJSONPrint
+				}
+
+				if err := report(rs, out); err != nil {
+					return err
+				}
+			}
+
+			if errors.As(rErr, &journal.ConflictError{}) {
+				return fmt.Errorf("%w\nuse --force to send it anyway, "+
+					"or --discard-conflicts to drop it", rErr)
+			}
+
+			return rErr
+		},
+	}
+
+	cmd.Flags().BoolVarP(&o.Force, "force", "F", false,
+		"send the changes even if they conflict with the ones on the API")
+	cmd.Flags().BoolVarP(&o.DiscardConflicts, "discard-conflicts", "D", false,
+		"drop the changes that conflict with the ones on the API")
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as json")
+
+	cmd.AddCommand(status.NewCmdStatus(f))
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package sync_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdSync(t *testing.T) {
+	start := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	base := dto.TimeEntryImpl{
+		ID:           "te1",
+		WorkspaceID:  "w",
+		UserID:       "u",
+		Description:  "Reading",
+		TimeInterval: dto.NewTimeInterval(start, nil),
+	}
+
+	out := api.OutParam{Workspace: "w", UserID: "u", End: end}
+	create := api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       end,
+		Description: "Writing",
+	}
+	del := api.DeleteTimeEntryParam{Workspace: "w", TimeEntryID: "te1"}
+
+	tts := []struct {
+		name     string
+		args     []string
+		ops      []journal.Operation
+		client   func(*mocks.MockClient)
+		expected string
+		err      string
+		pending  int
+	}{
+		{
+			name:     "nothing to sync",
+			expected: "nothing to sync\n",
+		},
+		{
+			name: "replays the changes",
+			ops: []journal.Operation{
+				{Kind: journal.KindOut, Workspace: "w", TimeEntryID: "te1",
+					Out: &out},
+				{Kind: journal.KindCreate, Workspace: "w", Create: &create},
+			},
+			client: func(c *mocks.MockClient) {
+				pp := api.GetTimeEntryInProgressParam{
+					Workspace: "w", UserID: "u"}
+				c.EXPECT().GetTimeEntryInProgress(pp).
+					Return(&base, nil).Once()
+				c.EXPECT().Out(out).Return(nil).Once()
+				c.EXPECT().GetTimeEntryInProgress(pp).
+					Return(nil, nil).Once()
+				c.EXPECT().CreateTimeEntry(create).
+					Return(dto.TimeEntryImpl{ID: "te2", WorkspaceID: "w"},
+						nil).Once()
+			},
+			expected: heredoc.Doc(`
+				+---+-------------------------+---------+------------+--------+
+				| # |        OPERATION        | STATUS  | TIME ENTRY | REASON |
+				+---+-------------------------+---------+------------+--------+
+				| 1 | stop running time entry | applied | te1        |        |
+				| 2 | create time entry       | applied | te2        |        |
+				+---+-------------------------+---------+------------+--------+
+			`),
+		},
+		{
+			name: "stops on conflicts",
+			ops: []journal.Operation{
+				{Kind: journal.KindDelete, Workspace: "w", TimeEntryID: "te1",
+					Base: &base, Delete: &del},
+			},
+			client: func(c *mocks.MockClient) {
+				edited := base
+				edited.Description = "Edited on the site"
+				c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
+					Workspace: "w", TimeEntryID: "te1"}).
+					Return(&edited, nil).Once()
+			},
+			err: "conflict on #1 (delete time entry): " +
+				"time entry te1 was changed remotely\n" +
+				"use --force to send it anyway, " +
+				"or --discard-conflicts to drop it",
+			pending: 1,
+		},
+		{
+			name: "discards conflicts",
+			args: []string{"--discard-conflicts"},
+			ops: []journal.Operation{
+				{Kind: journal.KindDelete, Workspace: "w", TimeEntryID: "te1",
+					Base: &base, Delete: &del},
+			},
+			client: func(c *mocks.MockClient) {
+				edited := base
+				edited.Description = "Edited on the site"
+				c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
+					Workspace: "w", TimeEntryID: "te1"}).
+					Return(&edited, nil).Once()
+			},
+			expected: heredoc.Doc(`
+				+---+-------------------+-----------+------------+--------------------------------+
+				| # |     OPERATION     |  STATUS   | TIME ENTRY |             REASON             |
+				+---+-------------------+-----------+------------+--------------------------------+
+				| 1 | delete time entry | discarded | te1        | time entry te1 was changed     |
+				|    |                   |           |            | remotely                       |
+				+---+-------------------+-----------+------------+--------------------------------+
+			`),
+		},
+		{
+			name: "force and discard conflicts",
+			args: []string{"--force", "--discard-conflicts"},
+			err: "the following flags can't be used together: " +
+				"`discard-conflicts` and `force`",
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			j := journal.New(t.TempDir())
+			for _, o := range tt.ops {
+				if _, err := j.Record(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().Journal().Return(j, nil).Maybe()
+			if tt.client != nil {
+				c := mocks.NewMockClient(t)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().Client().Return(c, nil)
+				tt.client(c)
+			}
+
+			cmd := sync.NewCmdSync(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			b := &bytes.Buffer{}
+			cmd.SetOut(b)
+			cmd.SetErr(b)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, b.String())
+
+			ops, err := j.Pending()
+			assert.NoError(t, err)
+			assert.Len(t, ops, tt.pending)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package add
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new", "create"},
+		Args:    cobra.NoArgs,
+		Short:   "Adds a new tag to the Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s --name "Code Review"
+			+--------------------------+-------------+----------+
+			|            ID            |    NAME     | ARCHIVED |
+			+--------------------------+-------------+----------+
+			| 62194867edaba27d0a45b464 | Code Review | NO       |
+			+--------------------------+-------------+----------+
+
+			$ %[1]s --name "Meeting" --quiet
+			6219485e8cb9606d934ebb5f
+
+			$ %[1]s --name "Meeting" # same name as existing one
+			add tag: Tag with name 'Meeting' already exists (code: 501)
+		`, "clockify-cli tag add"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString("name")
+			t, err := c.AddTag(api.AddTagParam{
+				Workspace: w,
+				Name:      name,
+			})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if report != nil {
+				return report(out, &of, t)
+			}
+
+			return util.Report([]dto.Tag{t}, out, of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "the name of the new tag")
+	_ = cmd.MarkFlagRequired("name")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
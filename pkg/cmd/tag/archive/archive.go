@@ -0,0 +1,48 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdArchive archives tags, so they can't be used on new time entries
+func NewCmdArchive(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:  "archive <tag>...",
+		Args: cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Archive tags on Clockify",
+		Long: heredoc.Doc(`
+			Archive tags on Clockify, similar to doing ` + "`tag edit <tag> --archived`" + `
+			Archived tags are kept on existing time entries, but can't be used on new ones
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s meeting "pair programming"
+			+--------------------------+------------------+----------+
+			|            ID            |       NAME       | ARCHIVED |
+			+--------------------------+------------------+----------+
+			| 6219485e8cb9606d934ebb5f | Meeting          | YES      |
+			| 621948708cb9606d934ebba7 | Pair Programming | YES      |
+			+--------------------------+------------------+----------+
+		`, "clockify-cli tag archive"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return util.ChangeArchived(cmd, f, of, args, true, report)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
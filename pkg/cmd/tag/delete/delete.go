@@ -0,0 +1,104 @@
+package del
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "delete <tag>...",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Deletes tags from a Clockify workspace",
+		Long: heredoc.Doc(`
+			Deletes tags from a Clockify workspace
+			This action can't be reverted, and all time entries using the tags will lose them
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s "pair programming"
+			+--------------------------+------------------+----------+
+			|            ID            |       NAME       | ARCHIVED |
+			+--------------------------+------------------+----------+
+			| 621948708cb9606d934ebba7 | Pair Programming | NO       |
+			+--------------------------+------------------+----------+
+
+			$ %[1]s 621948708cb9606d934ebba7 -q
+			621948708cb9606d934ebba7
+		`, "clockify-cli tag delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if strhlp.Search("", ids) != -1 {
+				return errors.New("tag id/name should not be empty")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetTagsByName(c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			g, ctx := errgroup.WithContext(cmd.Context())
+			gc := c.WithContext(ctx)
+			tags := make([]dto.Tag, len(ids))
+			for i := range ids {
+				j := i
+				g.Go(func() (err error) {
+					tags[j], err = gc.DeleteTag(api.DeleteTagParam{
+						Workspace: w,
+						TagID:     ids[j],
+					})
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, tags)
+			}
+
+			return util.Report(tags, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,155 @@
+package del_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/tag/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Tag) error
+
+func TestCmdDelete(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "tag is required",
+			err:  "requires arg tag",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "tag should not be empty",
+			args: []string{" "},
+			err:  "tag id/name should not be empty",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "tag lookup error",
+			args: []string{"meeting"},
+			err:  "No tag with id or name containing.*meeting",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Tag{}, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"t1"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("DeleteTag", api.DeleteTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+				}).Return(dto.Tag{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "delete by name",
+			args: []string{"meeting", "review"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				tags := []dto.Tag{
+					{ID: "t1", Name: "Meeting"},
+					{ID: "t2", Name: "Code Review"},
+				}
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(tags, nil)
+
+				for _, tag := range tags {
+					c.On("DeleteTag", api.DeleteTagParam{
+						Workspace: "w",
+						TagID:     tag.ID,
+					}).Return(tag, nil)
+				}
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+					called = true
+					assert.Equal(t, tags, ts)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Tag) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := del.NewCmdDelete(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
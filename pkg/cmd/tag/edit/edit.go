@@ -0,0 +1,134 @@
+package edit
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdEdit updates a tag
+func NewCmdEdit(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "edit <tag>...",
+		Aliases: []string{"update"},
+		Args:    cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Edit a tag",
+		Example: heredoc.Docf(`
+			# rename a tag
+			$ %[1]s "code review" --name "Review"
+			+--------------------------+--------+----------+
+			|            ID            |  NAME  | ARCHIVED |
+			+--------------------------+--------+----------+
+			| 62194867edaba27d0a45b464 | Review | NO       |
+			+--------------------------+--------+----------+
+
+			# archive multiple tags
+			$ %[1]s meeting 621948708cb9606d934ebba7 --archived \
+				--format "{{.Name}} | {{.Archived}}"
+			Meeting | true
+			Pair Programming | true
+		`, "clockify-cli tag edit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "archived", "active"); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("name") &&
+				!cmd.Flags().Changed("archived") &&
+				!cmd.Flags().Changed("active") {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"nothing to change, use `--name`, `--archived` " +
+						"or `--active`"))
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if strhlp.Search("", ids) != -1 {
+				return errors.New("tag id/name should not be empty")
+			}
+
+			if len(ids) > 1 && cmd.Flags().Changed("name") {
+				return errors.New(
+					"`--name` can't be changed for multiple tags")
+			}
+
+			name, _ := cmd.Flags().GetString("name")
+			name = strings.TrimSpace(name)
+			if cmd.Flags().Changed("name") && name == "" {
+				return errors.New("tag name should not be empty")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetTagsByName(c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			var archived *bool
+			if cmd.Flags().Changed("archived") ||
+				cmd.Flags().Changed("active") {
+				b, _ := cmd.Flags().GetBool("archived")
+				archived = &b
+			}
+
+			tags, err := util.UpdateTags(cmd.Context(), c, w, ids,
+				func(p *api.UpdateTagParam) {
+					if name != "" {
+						p.Name = name
+					}
+
+					if archived != nil {
+						p.Archived = archived
+					}
+				})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, tags)
+			}
+
+			return util.Report(tags, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "new name of the tag")
+	cmd.Flags().BoolP("archived", "A", false, "set the tags as archived")
+	cmd.Flags().BoolP("active", "a", false, "set the tags as active")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,243 @@
+package edit_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Tag) error
+
+func TestCmdEdit(t *testing.T) {
+	bTrue := true
+	bFalse := false
+	tags := []dto.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Code Review"},
+		{ID: "t3", Name: "Old", Archived: true},
+	}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "tag is required",
+			err:  "requires arg tag",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j", "t1"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "active or archived",
+			args: []string{"--active", "--archived", "t1"},
+			err:  "flags can't be used together.*active.*archived",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "nothing to change",
+			args: []string{"t1"},
+			err:  "nothing to change, use `--name`, `--archived` or `--active`",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "can only change the name of one tag",
+			args: []string{"t1", "t2", "-n=wrong"},
+			err:  "`--name` can't be changed for multiple tags",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "name should not be empty",
+			args: []string{"t1", "-n= "},
+			err:  "tag name should not be empty",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "tag not found",
+			args: []string{"t9", "--archived"},
+			err:  "tag with id t9 was not found",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(tags, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"t1", "-n=Meetings"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(tags, nil)
+
+				c.On("UpdateTag", api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+					Name:      "Meetings",
+					Archived:  &bFalse,
+				}).Return(dto.Tag{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "rename by name",
+			args: []string{"meeting", "-n=Meetings"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(tags, nil)
+
+				r := dto.Tag{ID: "t1", Name: "Meetings"}
+				c.On("UpdateTag", api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+					Name:      "Meetings",
+					Archived:  &bFalse,
+				}).Return(r, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+					called = true
+					assert.Equal(t, []dto.Tag{r}, ts)
+					return nil
+				}
+			},
+		},
+		{
+			name: "archive multiple tags keeping names",
+			args: []string{"t1", "t2", "--archived"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("WithContext", mock.Anything).Return(c).Maybe()
+				c.On("GetTags", api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(tags, nil)
+
+				r1 := dto.Tag{ID: "t1", Name: "Meeting", Archived: true}
+				c.On("UpdateTag", api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+					Name:      "Meeting",
+					Archived:  &bTrue,
+				}).Return(r1, nil)
+
+				r2 := dto.Tag{ID: "t2", Name: "Code Review", Archived: true}
+				c.On("UpdateTag", api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t2",
+					Name:      "Code Review",
+					Archived:  &bTrue,
+				}).Return(r2, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+					called = true
+					assert.Equal(t, []dto.Tag{r1, r2}, ts)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Tag) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := edit.NewCmdEdit(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
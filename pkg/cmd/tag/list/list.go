@@ -0,0 +1,89 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List tags from a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+------------------+----------+
+			|            ID            |       NAME       | ARCHIVED |
+			+--------------------------+------------------+----------+
+			| 62194867edaba27d0a45b464 | Code Review      | NO       |
+			| 6219485e8cb9606d934ebb5f | Meeting          | NO       |
+			| 621948708cb9606d934ebba7 | Pair Programming | NO       |
+			| 6143b768195e5c503960a775 | Special Tag      | NO       |
+			+--------------------------+------------------+----------+
+
+			$ %[1]s --name code -q
+			62194867edaba27d0a45b464
+
+			$ %[1]s --format "{{.Name}}" --archived
+			Archived Tag
+
+			$ %[1]s --name meeting --csv
+			id,name,archived
+			6219485e8cb9606d934ebb5f,Meeting,false
+		`, "clockify-cli tag list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.GetTagsParam{
+				PaginationParam: api.AllPages(),
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p.Name, _ = cmd.Flags().GetString("name")
+			archived, _ := cmd.Flags().GetBool("archived")
+			p.Archived = &archived
+
+			tags, err := c.GetTags(p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, tags)
+			}
+
+			return util.Report(tags, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "",
+		"will be used to filter the tag by name")
+	cmd.Flags().BoolP("archived", "", false, "only display archived tags")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package list_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdList(t *testing.T) {
+	bTrue := true
+	bFalse := false
+
+	tts := []struct {
+		name     string
+		args     []string
+		param    api.GetTagsParam
+		expected string
+	}{
+		{
+			name: "active tags",
+			args: []string{"-q"},
+			param: api.GetTagsParam{
+				Workspace:       "w",
+				Archived:        &bFalse,
+				PaginationParam: api.AllPages(),
+			},
+			expected: "t1\nt2\n",
+		},
+		{
+			name: "archived tags by name",
+			args: []string{"--archived", "-n=meet", "--format={{.Name}}"},
+			param: api.GetTagsParam{
+				Workspace:       "w",
+				Name:            "meet",
+				Archived:        &bTrue,
+				PaginationParam: api.AllPages(),
+			},
+			expected: "Meeting\nCode Review\n",
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.On("GetWorkspaceID").Return("w", nil)
+
+			c := mocks.NewMockClient(t)
+			f.On("Client").Return(c, nil)
+			c.On("GetTags", tt.param).Return([]dto.Tag{
+				{ID: "t1", Name: "Meeting"},
+				{ID: "t2", Name: "Code Review"},
+			}, nil)
+
+			cmd := list.NewCmdList(f, nil)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			b := &bytes.Buffer{}
+			cmd.SetOut(b)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, b.String())
+		})
+	}
+}
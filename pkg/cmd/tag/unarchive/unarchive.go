@@ -0,0 +1,46 @@
+package unarchive
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdUnarchive activates archived tags
+func NewCmdUnarchive(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "unarchive <tag>...",
+		Aliases: []string{"activate"},
+		Args:    cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Activate archived tags on Clockify",
+		Long: "Activate archived tags on Clockify, similar to doing " +
+			"`tag edit <tag> --active`",
+		Example: heredoc.Docf(`
+			$ %[1]s meeting
+			+--------------------------+---------+----------+
+			|            ID            |  NAME   | ARCHIVED |
+			+--------------------------+---------+----------+
+			| 6219485e8cb9606d934ebb5f | Meeting | NO       |
+			+--------------------------+---------+----------+
+		`, "clockify-cli tag unarchive"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return util.ChangeArchived(cmd, f, of, args, false, report)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
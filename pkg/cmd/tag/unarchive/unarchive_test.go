@@ -0,0 +1,58 @@
+package unarchive_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/unarchive"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdUnarchive(t *testing.T) {
+	bFalse := false
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	f.On("Config").Return(cf)
+	cf.On("IsAllowNameForID").Return(true)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+	c.On("WithContext", mock.Anything).Return(c).Maybe()
+	c.On("GetTags", api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Tag{
+		{ID: "t1", Name: "Meeting", Archived: true},
+		{ID: "t2", Name: "Code Review"},
+	}, nil)
+
+	r := dto.Tag{ID: "t1", Name: "Meeting"}
+	c.On("UpdateTag", api.UpdateTagParam{
+		Workspace: "w",
+		TagID:     "t1",
+		Name:      "Meeting",
+		Archived:  &bFalse,
+	}).Return(r, nil)
+
+	called := false
+	cmd := unarchive.NewCmdUnarchive(f, func(
+		_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+		called = true
+		assert.Equal(t, []dto.Tag{r}, ts)
+		return nil
+	})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"meeting"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
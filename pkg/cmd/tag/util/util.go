@@ -0,0 +1,171 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/tag"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// OutputFlags sets how to print out a list of tags
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+	Quiet  bool
+}
+
+// Check guaranties that only one type of output is chosen
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for tags
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Tag")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the tags
+func Report(ts []dto.Tag, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.TagsJSONPrint(ts, out)
+	case of.CSV:
+		return output.TagsCSVPrint(ts, out)
+	case of.Format != "":
+		return output.TagPrintWithTemplate(of.Format)(ts, out)
+	case of.Quiet:
+		return output.TagPrintQuietly(ts, out)
+	default:
+		return output.TagPrint(ts, out)
+	}
+}
+
+// UpdateTags applies the changes set by fn to each one of the tags.
+//
+// Clockify requires the name of the tag on every update, so the current tags
+// are loaded first and their names and status are used as the base params.
+func UpdateTags(
+	ctx context.Context,
+	c api.Client,
+	workspace string,
+	ids []string,
+	fn func(*api.UpdateTagParam),
+) ([]dto.Tag, error) {
+	current, err := c.GetTags(api.GetTagsParam{
+		Workspace:       workspace,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]api.UpdateTagParam, len(ids))
+	for i := range ids {
+		found := false
+		for _, t := range current {
+			if t.ID != ids[i] {
+				continue
+			}
+
+			archived := t.Archived
+			params[i] = api.UpdateTagParam{
+				Workspace: workspace,
+				TagID:     t.ID,
+				Name:      t.Name,
+				Archived:  &archived,
+			}
+			found = true
+			break
+		}
+
+		if !found {
+			return nil, api.EntityNotFound{
+				EntityName: "tag",
+				ID:         ids[i],
+			}
+		}
+
+		fn(&params[i])
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	gc := c.WithContext(ctx)
+	tags := make([]dto.Tag, len(ids))
+	for i := range params {
+		j := i
+		g.Go(func() (err error) {
+			tags[j], err = gc.UpdateTag(params[j])
+			return err
+		})
+	}
+
+	return tags, g.Wait()
+}
+
+// ChangeArchived archives or activates the tags informed as arguments, used
+// by the archive and unarchive commands
+func ChangeArchived(
+	cmd *cobra.Command,
+	f cmdutil.Factory,
+	of OutputFlags,
+	args []string,
+	archived bool,
+	report func(io.Writer, *OutputFlags, []dto.Tag) error,
+) error {
+	if err := of.Check(); err != nil {
+		return err
+	}
+
+	ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+	if strhlp.Search("", ids) != -1 {
+		return errors.New("tag id/name should not be empty")
+	}
+
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	if f.Config().IsAllowNameForID() {
+		if ids, err = search.GetTagsByName(c, w, ids); err != nil {
+			return err
+		}
+	}
+
+	tags, err := UpdateTags(cmd.Context(), c, w, ids, func(p *api.UpdateTagParam) {
+		p.Archived = &archived
+	})
+	if err != nil {
+		return err
+	}
+
+	if report != nil {
+		return report(cmd.OutOrStdout(), &of, tags)
+	}
+
+	return Report(tags, cmd.OutOrStdout(), of)
+}
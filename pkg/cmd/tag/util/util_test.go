@@ -0,0 +1,67 @@
+package util_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateTags(t *testing.T) {
+	bTrue := true
+	tags := []dto.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Old", Archived: true},
+	}
+
+	t.Run("tag not found", func(t *testing.T) {
+		c := mocks.NewMockClient(t)
+		c.On("GetTags", api.GetTagsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return(tags, nil)
+
+		_, err := util.UpdateTags(context.Background(), c, "w",
+			[]string{"t9"}, func(*api.UpdateTagParam) {
+				t.Error("should not be called")
+			})
+		assert.EqualError(t, err, "tag with id t9 was not found")
+	})
+
+	t.Run("keeps the current name and status", func(t *testing.T) {
+		c := mocks.NewMockClient(t)
+		c.On("WithContext", mock.Anything).Return(c)
+		c.On("GetTags", api.GetTagsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return(tags, nil)
+
+		r1 := dto.Tag{ID: "t1", Name: "Meeting", Archived: true}
+		c.On("UpdateTag", api.UpdateTagParam{
+			Workspace: "w",
+			TagID:     "t1",
+			Name:      "Meeting",
+			Archived:  &bTrue,
+		}).Return(r1, nil)
+
+		r2 := dto.Tag{ID: "t2", Name: "Old", Archived: true}
+		c.On("UpdateTag", api.UpdateTagParam{
+			Workspace: "w",
+			TagID:     "t2",
+			Name:      "Old",
+			Archived:  &bTrue,
+		}).Return(r2, nil)
+
+		ts, err := util.UpdateTags(context.Background(), c, "w",
+			[]string{"t1", "t2"}, func(p *api.UpdateTagParam) {
+				p.Archived = &bTrue
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.Tag{r1, r2}, ts)
+	})
+}
@@ -112,11 +112,12 @@ func NewCmdDone(
 			}
 
 			tasks := make([]dto.Task, len(ids))
-			var g errgroup.Group
+			g, ctx := errgroup.WithContext(cmd.Context())
+			gc := c.WithContext(ctx)
 			for i := 0; i < len(ids); i++ {
 				j := i
 				g.Go(func() error {
-					t, err := c.GetTask(api.GetTaskParam{
+					t, err := gc.GetTask(api.GetTaskParam{
 						Workspace: workspace,
 						ProjectID: project,
 						TaskID:    ids[j],
@@ -125,7 +126,7 @@ func NewCmdDone(
 						return err
 					}
 
-					tasks[j], err = c.UpdateTask(api.UpdateTaskParam{
+					tasks[j], err = gc.UpdateTask(api.UpdateTaskParam{
 						Workspace: workspace,
 						ProjectID: t.ProjectID,
 						TaskID:    t.ID,
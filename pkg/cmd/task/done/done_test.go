@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdDone(t *testing.T) {
@@ -112,6 +113,7 @@ func TestCmdDone(t *testing.T) {
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
@@ -135,6 +137,7 @@ func TestCmdDone(t *testing.T) {
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
@@ -168,6 +171,7 @@ func TestCmdDone(t *testing.T) {
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
@@ -228,6 +232,7 @@ func TestCmdDone(t *testing.T) {
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
@@ -296,6 +301,7 @@ func TestCmdDone(t *testing.T) {
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
@@ -406,6 +412,7 @@ func TestCmdDoneReport(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := mocks.NewMockFactory(t)
 			c := mocks.NewMockClient(t)
+			c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 			f.On("Client").Return(c, nil)
 			f.On("GetWorkspaceID").
 				Return("w", nil)
@@ -0,0 +1,125 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(f cmdutil.Factory) *cobra.Command {
+	var (
+		t            cmdutil.TimeEntryTemplate
+		billable     bool
+		notBillable  bool
+		customFields []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "add <name>",
+		Aliases: []string{"new", "create"},
+		Args:    cmdutil.RequiredNamedArgs("name"),
+		Short:   "Saves a new time entry template on the config",
+		Long: heredoc.Doc(`
+			Saves a new time entry template on the config, to be used by "template apply".
+
+			Project, task and tags can be informed by their IDs, or by their names if the config "allow-name-for-id" is enabled; they are looked up when the template is applied.
+
+			Custom fields are informed by their ID, as "<id>=<value>".
+
+			If no weekday is informed, the template will be used on the days set on the config "workweek-days".
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli template add "Stand-up" -p "Meetings" -d "Daily stand-up" --start 09:30 --duration 15m
+			$ clockify-cli template add 1:1 -p "Meetings" -T "1:1" --start 14:00 --duration 30m --weekday thursday
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"billable":     billable,
+				"not-billable": notBillable,
+			}); err != nil {
+				return err
+			}
+
+			t.Name = strings.TrimSpace(args[0])
+			if billable || notBillable {
+				t.Billable = &billable
+			}
+
+			for _, cf := range customFields {
+				id, v, ok := strings.Cut(cf, "=")
+				if !ok || strings.TrimSpace(id) == "" {
+					return fmt.Errorf(
+						"custom field \"%s\" is invalid, use <id>=<value>", cf)
+				}
+
+				if t.CustomFields == nil {
+					t.CustomFields = map[string]string{}
+				}
+				t.CustomFields[strings.TrimSpace(id)] = v
+			}
+
+			if err := t.Validate(); err != nil {
+				return err
+			}
+
+			cnf := f.Config()
+			ts, err := cnf.GetTimeEntryTemplates()
+			if err != nil {
+				return err
+			}
+
+			if util.Search(ts, t.Name) != -1 {
+				return errors.New(
+					"template \"" + t.Name + "\" already exists")
+			}
+
+			cnf.SetTimeEntryTemplates(append(ts, t))
+			return cnf.Save()
+		},
+	}
+
+	cmd.Flags().StringVarP(&t.Description, "description", "d", "",
+		"time entry description")
+	cmd.Flags().StringVarP(&t.Project, "project", "p", "",
+		"project to use for time entry")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f, f.Config()))
+	cmd.Flags().StringVarP(&t.Client, "client", "c", "",
+		"client of the project to use for time entry")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "client",
+		cmdcomplutil.NewClientAutoComplete(f))
+	cmd.Flags().StringVar(&t.Task, "task", "", "add a task to the entry")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "task",
+		cmdcomplutil.NewTaskAutoComplete(f, true))
+	cmd.Flags().StringSliceVarP(&t.Tags, "tag", "T", []string{},
+		"add tags to the entry (can be used multiple times)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+	cmd.Flags().BoolVarP(&billable, "billable", "b", false,
+		"this time entry is billable")
+	cmd.Flags().BoolVarP(&notBillable, "not-billable", "n", false,
+		"this time entry is not billable")
+	cmd.Flags().StringArrayVar(&customFields, "custom-field", []string{},
+		"set a custom field of the entry as <id>=<value> "+
+			"(can be used multiple times)")
+
+	cmd.Flags().StringVar(&t.Start, "start", "09:00",
+		"time of the day the entries start")
+	cmd.Flags().StringVar(&t.Duration, "duration", "",
+		"duration of the entries (like 15m or 1h30m)")
+	_ = cmd.MarkFlagRequired("duration")
+	cmd.Flags().StringSliceVar(&t.Weekdays, "weekday", []string{},
+		"days of the week to create the entries (can be used multiple times)")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "weekday",
+		cmdcompl.ValidArgsSlide(cmdutil.GetWeekdays()))
+
+	return cmd
+}
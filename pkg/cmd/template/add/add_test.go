@@ -0,0 +1,113 @@
+package add_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdAdd(t *testing.T) {
+	existing := cmdutil.TimeEntryTemplate{
+		Name: "Stand-up", Start: "09:30", Duration: "15m"}
+
+	tts := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{
+			name: "duration is required",
+			args: []string{"1:1"},
+			err:  `required flag(s) "duration" not set`,
+		},
+		{
+			name: "invalid start",
+			args: []string{"1:1", "--duration", "30m", "--start", "2pm"},
+			err:  `start "2pm" is invalid, use a time like "09:30"`,
+		},
+		{
+			name: "invalid custom field",
+			args: []string{"1:1", "--duration", "30m", "--custom-field", "a"},
+			err:  `custom field "a" is invalid, use <id>=<value>`,
+		},
+		{
+			name: "billable and not billable",
+			args: []string{"1:1", "--duration", "30m", "-b", "-n"},
+			err: "the following flags can't be used together: " +
+				"`billable` and `not-billable`",
+		},
+	}
+
+	for i := range tts {
+		tt := tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.On("Config").Return(&mocks.SimpleConfig{})
+
+			cmd := add.NewCmdAdd(f)
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(&bytes.Buffer{})
+
+			_, err := cmd.ExecuteC()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+
+	t.Run("already exists", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		cnf := mocks.NewMockConfig(t)
+		f.On("Config").Return(cnf)
+		cnf.On("GetTimeEntryTemplates").
+			Return([]cmdutil.TimeEntryTemplate{existing}, nil)
+
+		cmd := add.NewCmdAdd(f)
+		cmd.SetArgs([]string{"stand-up", "--duration", "15m"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, `template "stand-up" already exists`)
+	})
+
+	t.Run("saves the template", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		cnf := mocks.NewMockConfig(t)
+		f.On("Config").Return(cnf)
+		cnf.On("GetTimeEntryTemplates").
+			Return([]cmdutil.TimeEntryTemplate{existing}, nil)
+
+		b := false
+		cnf.On("SetTimeEntryTemplates", []cmdutil.TimeEntryTemplate{
+			existing,
+			{
+				Name:         "1:1",
+				Project:      "Meetings",
+				Tags:         []string{"1:1", "people"},
+				Billable:     &b,
+				CustomFields: map[string]string{"cf1": "a=b"},
+				Start:        "14:00",
+				Duration:     "30m",
+				Weekdays:     []string{"thursday"},
+			},
+		}).Once()
+		cnf.On("Save").Return(nil).Once()
+
+		cmd := add.NewCmdAdd(f)
+		cmd.SetArgs([]string{"1:1", "-p", "Meetings", "-T", "1:1,people",
+			"--not-billable", "--custom-field", "cf1=a=b",
+			"--start", "14:00", "--duration", "30m", "--weekday", "thursday"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+	})
+}
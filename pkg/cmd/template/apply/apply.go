@@ -0,0 +1,269 @@
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	tplutil "github.com/lucassabreu/clockify-cli/pkg/cmd/template/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+var ranges = cmdcompl.ValidArgsMap(timehlp.NamedRanges)
+
+// NewCmdApply represents the apply command
+func NewCmdApply(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng    string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply [<name>...]",
+		Short: "Creates the time entries of templates for the days of a range",
+		Long: heredoc.Doc(`
+			Creates the time entries of the templates informed (or all of them) for each day of the range that matches the weekdays of the template.
+
+			Days that already have a time entry with the same description, project and task are skipped, so applying the same range again will not duplicate them.
+
+			The ranges accepted are:
+		`) + ranges.Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli template apply --range this-week
+			+ 2026-10-12 09:30 - 09:45 Stand-up
+			= 2026-10-13 Stand-up: already exists as 62b87a9785815e619d7ce02e
+			+ 2026-10-14 09:30 - 09:45 Stand-up
+			+ 2026-10-15 09:30 - 09:45 Stand-up
+			+ 2026-10-15 14:00 - 14:30 1:1
+			+ 2026-10-16 09:30 - 09:45 Stand-up
+			5 time entries were created, 1 skipped
+
+			$ clockify-cli template apply 1:1 --range next-month --dry-run
+		`),
+		ValidArgsFunction: func(
+			_ *cobra.Command, _ []string, _ string,
+		) ([]string, cobra.ShellCompDirective) {
+			return tplutil.ValidArgs(f), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			first, last, err := timehlp.GetNamedRange(rng, timehlp.Today())
+			if err != nil {
+				return err
+			}
+
+			cnf := f.Config()
+			ts, err := cnf.GetTimeEntryTemplates()
+			if err != nil {
+				return err
+			}
+
+			if ts, err = filter(ts, args); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			logs, err := c.LogRange(api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       first,
+				LastDate:        last.AddDate(0, 0, 1),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			r := run{
+				out:      cmd.OutOrStdout(),
+				c:        c,
+				logs:     logs,
+				workweek: cnf.GetWorkWeekdays(),
+				dryRun:   dryRun,
+			}
+
+			steps := []util.Step{
+				util.GetAllowNameForIDsFn(cnf, c),
+				util.GetValidateTimeEntryFn(f),
+				util.FillMissingBillableFn(c),
+			}
+
+			for _, t := range ts {
+				te, err := util.Do(util.TimeEntryDTO{
+					Workspace:   w,
+					UserID:      u,
+					ProjectID:   t.Project,
+					Client:      t.Client,
+					TaskID:      t.Task,
+					Description: t.Description,
+					TagIDs:      t.Tags,
+					Billable:    t.Billable,
+				}, steps...)
+				if err != nil {
+					return fmt.Errorf("template \"%s\": %w", t.Name, err)
+				}
+
+				for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+					if !t.RunsOn(d, r.workweek) {
+						continue
+					}
+
+					if err := r.apply(t, te, d); err != nil {
+						return fmt.Errorf("template \"%s\": %w", t.Name, err)
+					}
+				}
+			}
+
+			verb := "were created"
+			if dryRun {
+				verb = "would be created"
+			}
+
+			_, err = fmt.Fprintf(r.out, "%d time entries %s", r.created, verb)
+			if err == nil && r.skipped > 0 {
+				_, err = fmt.Fprintf(r.out, ", %d skipped", r.skipped)
+			}
+			if err == nil {
+				_, err = fmt.Fprintln(r.out)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "this-week",
+		"range of days to create the time entries")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range", ranges)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the time entries that would be created")
+
+	return cmd
+}
+
+// filter returns the templates with the names informed, or all of them if
+// none is informed
+func filter(
+	ts []cmdutil.TimeEntryTemplate, names []string,
+) ([]cmdutil.TimeEntryTemplate, error) {
+	if len(names) == 0 {
+		if len(ts) == 0 {
+			return ts, errors.New(
+				"there are no templates, add one with \"template add\"")
+		}
+
+		return ts, nil
+	}
+
+	s := make([]cmdutil.TimeEntryTemplate, len(names))
+	for i, n := range names {
+		j := tplutil.Search(ts, n)
+		if j == -1 {
+			return s, errors.New("template \"" + n + "\" not found")
+		}
+
+		s[i] = ts[j]
+	}
+
+	return s, nil
+}
+
+type run struct {
+	out      io.Writer
+	c        api.Client
+	logs     []dto.TimeEntry
+	workweek []string
+	dryRun   bool
+
+	created int
+	skipped int
+}
+
+// apply creates the time entry of the template on the day, unless there is
+// already a matching time entry on it
+func (r *run) apply(
+	t cmdutil.TimeEntryTemplate, te util.TimeEntryDTO, day time.Time,
+) error {
+	start, end, err := t.Interval(day)
+	if err != nil {
+		return err
+	}
+
+	date := day.Format("2006-01-02")
+	if id, ok := r.existing(te, day); ok {
+		r.skipped++
+		_, err := fmt.Fprintf(r.out, "= %s %s: already exists as %s\n",
+			date, t.Name, id)
+		return err
+	}
+
+	if _, err := fmt.Fprintf(r.out, "+ %s %s - %s %s\n",
+		date, start.Format("15:04"), end.Format("15:04"), t.Name,
+	); err != nil {
+		return err
+	}
+
+	r.created++
+	if r.dryRun {
+		return nil
+	}
+
+	_, err = r.c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:    te.Workspace,
+		Start:        start,
+		End:          &end,
+		Billable:     te.Billable,
+		Description:  te.Description,
+		ProjectID:    te.ProjectID,
+		TaskID:       te.TaskID,
+		TagIDs:       te.TagIDs,
+		CustomFields: t.CustomFieldValues(),
+	})
+	return err
+}
+
+// existing looks for a time entry on the day with the same description,
+// project and task of the template
+func (r *run) existing(te util.TimeEntryDTO, day time.Time) (string, bool) {
+	for _, l := range r.logs {
+		s := l.TimeInterval.Start.In(day.Location())
+		if s.Year() != day.Year() || s.YearDay() != day.YearDay() {
+			continue
+		}
+
+		taskID := ""
+		if l.Task != nil {
+			taskID = l.Task.ID
+		}
+
+		if l.ProjectID == te.ProjectID && taskID == te.TaskID &&
+			strings.EqualFold(
+				strings.TrimSpace(l.Description),
+				strings.TrimSpace(te.Description)) {
+			return l.ID, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,177 @@
+package apply_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/apply"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdApply(t *testing.T) {
+	b := true
+	templates := []cmdutil.TimeEntryTemplate{
+		{
+			Name:         "Stand-up",
+			Description:  "Daily stand-up",
+			Project:      "p1",
+			Billable:     &b,
+			CustomFields: map[string]string{"cf2": "b", "cf1": "a"},
+			Start:        "09:30",
+			Duration:     "15m",
+		},
+		{
+			Name:     "1:1",
+			Project:  "p1",
+			Task:     "t1",
+			Tags:     []string{"tag1"},
+			Billable: &b,
+			Start:    "14:00",
+			Duration: "30m",
+			Weekdays: []string{"thursday"},
+		},
+	}
+
+	sunday, saturday := timehlp.GetWeekRange(
+		timehlp.Today().AddDate(0, 0, -7))
+	monday := sunday.AddDate(0, 0, 1)
+
+	factory := func(t *testing.T) (*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{
+			AllowIncomplete:    true,
+			TimeEntryTemplates: templates,
+			WorkweekDays: []string{
+				"monday", "tuesday", "wednesday", "thursday", "friday"},
+		})
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+
+		c.On("LogRange", api.LogRangeParam{
+			Workspace:       "w",
+			UserID:          "u",
+			FirstDate:       sunday,
+			LastDate:        saturday.AddDate(0, 0, 1),
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.TimeEntry{{
+			ID:          "te0",
+			Description: "daily stand-up",
+			ProjectID:   "p1",
+			TimeInterval: dto.NewTimeInterval(
+				monday.Add(10*time.Hour), nil),
+		}}, nil)
+
+		return f, c
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		f, _ := factory(t)
+
+		out := &bytes.Buffer{}
+		cmd := apply.NewCmdApply(f)
+		cmd.SetArgs([]string{"--range", "last-week", "--dry-run"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+
+		day := func(i int) string {
+			return sunday.AddDate(0, 0, i).Format("2006-01-02")
+		}
+		assert.Equal(t, heredoc.Docf(`
+			= %s Stand-up: already exists as te0
+			+ %s 09:30 - 09:45 Stand-up
+			+ %s 09:30 - 09:45 Stand-up
+			+ %s 09:30 - 09:45 Stand-up
+			+ %s 09:30 - 09:45 Stand-up
+			+ %s 14:00 - 14:30 1:1
+			5 time entries would be created, 1 skipped
+		`, day(1), day(2), day(3), day(4), day(5), day(4)), out.String())
+	})
+
+	t.Run("creates time entries", func(t *testing.T) {
+		f, c := factory(t)
+
+		start := sunday.AddDate(0, 0, 4).Add(14 * time.Hour)
+		end := start.Add(30 * time.Minute)
+		c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+			Workspace: "w",
+			Start:     start,
+			End:       &end,
+			Billable:  &b,
+			ProjectID: "p1",
+			TaskID:    "t1",
+			TagIDs:    []string{"tag1"},
+		}).Return(dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+
+		start2 := sunday.AddDate(0, 0, 2).Add(9*time.Hour + 30*time.Minute)
+		end2 := start2.Add(15 * time.Minute)
+		c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+			Workspace:   "w",
+			Start:       start2,
+			End:         &end2,
+			Billable:    &b,
+			Description: "Daily stand-up",
+			ProjectID:   "p1",
+			CustomFields: []dto.CustomFieldValue{
+				{CustomFieldID: "cf1", Value: "a"},
+				{CustomFieldID: "cf2", Value: "b"},
+			},
+		}).Return(dto.TimeEntryImpl{ID: "te2"}, nil).Once()
+
+		c.On("CreateTimeEntry", mock.Anything).
+			Return(dto.TimeEntryImpl{ID: "te3"}, nil).Times(3)
+
+		out := &bytes.Buffer{}
+		cmd := apply.NewCmdApply(f)
+		cmd.SetArgs([]string{"-r", "last-week"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(),
+			"\n5 time entries were created, 1 skipped\n")
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{
+			TimeEntryTemplates: templates,
+		})
+
+		cmd := apply.NewCmdApply(f)
+		cmd.SetArgs([]string{"stand-up", "retro"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, `template "retro" not found`)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		cmd := apply.NewCmdApply(mocks.NewMockFactory(t))
+		cmd.SetArgs([]string{"--range", "forever"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.ErrorContains(t, err, `range "forever" is invalid`)
+	})
+}
@@ -0,0 +1,105 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(f cmdutil.Factory) *cobra.Command {
+	var (
+		asJSON bool
+		quiet  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the time entry templates saved on the config",
+		Example: heredoc.Doc(`
+			$ clockify-cli template list
+			+----------+----------------+----------+------+-------+----------+----------+
+			|   NAME   |  DESCRIPTION   | PROJECT  | TAGS | START | DURATION | WEEKDAYS |
+			+----------+----------------+----------+------+-------+----------+----------+
+			| Stand-up | Daily stand-up | Meetings |      | 09:30 | 15m      |          |
+			| 1:1      |                | Meetings | 1:1  | 14:00 | 30m      | thursday |
+			+----------+----------------+----------+------+-------+----------+----------+
+
+			$ clockify-cli template list --quiet
+			Stand-up
+			1:1
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"json":  asJSON,
+				"quiet": quiet,
+			}); err != nil {
+				return err
+			}
+
+			ts, err := f.Config().GetTimeEntryTemplates()
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			switch {
+			case asJSON:
+				if ts == nil {
+					ts = []cmdutil.TimeEntryTemplate{}
+				}
+				return json.NewEncoder(out).Encode(ts)
+			case quiet:
+				for i := range ts {
+					if _, err := fmt.Fprintln(out, ts[i].Name); err != nil {
+						return err
+					}
+				}
+				return nil
+			default:
+				report(out, ts)
+				return nil
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "only display names")
+
+	return cmd
+}
+
+func report(out io.Writer, ts []cmdutil.TimeEntryTemplate) {
+	tw := tablewriter.NewWriter(out)
+	tw.SetHeader([]string{
+		"Name", "Description", "Project", "Tags", "Start", "Duration",
+		"Weekdays",
+	})
+
+	for _, t := range ts {
+		p := t.Project
+		if t.Task != "" {
+			p += " / " + t.Task
+		}
+
+		tw.Append([]string{
+			t.Name,
+			t.Description,
+			p,
+			strings.Join(t.Tags, ", "),
+			t.Start,
+			t.Duration,
+			strings.Join(t.Weekdays, ", "),
+		})
+	}
+
+	tw.Render()
+}
@@ -0,0 +1,49 @@
+package remove
+
+import (
+	"errors"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemove represents the remove command
+func NewCmdRemove(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name>...",
+		Aliases: []string{"rm", "delete", "del"},
+		Args:    cmdutil.RequiredNamedArgs("name"),
+		ValidArgsFunction: func(
+			_ *cobra.Command, _ []string, _ string,
+		) ([]string, cobra.ShellCompDirective) {
+			return util.ValidArgs(f), cobra.ShellCompDirectiveNoFileComp
+		},
+		Short: "Removes time entry templates from the config",
+		Example: heredoc.Doc(`
+			$ clockify-cli template remove "Stand-up" 1:1
+		`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cnf := f.Config()
+			ts, err := cnf.GetTimeEntryTemplates()
+			if err != nil {
+				return err
+			}
+
+			for _, n := range args {
+				i := util.Search(ts, n)
+				if i == -1 {
+					return errors.New("template \"" + n + "\" not found")
+				}
+
+				ts = append(ts[:i], ts[i+1:]...)
+			}
+
+			cnf.SetTimeEntryTemplates(ts)
+			return cnf.Save()
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,30 @@
+package template
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/apply"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/template/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTemplate represents the template command
+func NewCmdTemplate(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "template",
+		Aliases: []string{"templates", "tpl"},
+		Short:   "Work with time entry templates",
+		Long: "Work with time entry templates, which are saved on the " +
+			"config and used to create recurring time entries (like " +
+			"stand-ups and 1:1s) for the days of a range",
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f))
+	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(remove.NewCmdRemove(f))
+	cmd.AddCommand(apply.NewCmdApply(f))
+
+	return cmd
+}
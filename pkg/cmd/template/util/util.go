@@ -0,0 +1,31 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+)
+
+// Search returns the position of the template with the name informed, or -1
+// if there is none
+func Search(ts []cmdutil.TimeEntryTemplate, name string) int {
+	name = strings.TrimSpace(name)
+	for i := range ts {
+		if strings.EqualFold(ts[i].Name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ValidArgs suggests the names of the templates saved
+func ValidArgs(f cmdutil.Factory) []string {
+	ts, _ := f.Config().GetTimeEntryTemplates()
+	names := make([]string, len(ts))
+	for i := range ts {
+		names[i] = ts[i].Name
+	}
+
+	return names
+}
@@ -0,0 +1,229 @@
+package balance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// Period is the time tracked and expected on a range of days
+type Period struct {
+	Name     string       `json:"name"`
+	First    string       `json:"first"`
+	Last     string       `json:"last"`
+	Tracked  dto.Duration `json:"tracked"`
+	Expected dto.Duration `json:"expected"`
+	Balance  dto.Duration `json:"balance"`
+}
+
+// NewCmdBalance represents the balance command
+func NewCmdBalance(f cmdutil.Factory) *cobra.Command {
+	var (
+		since  string
+		asJSON bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Args:  cobra.NoArgs,
+		Short: "Shows the time tracked against the time expected",
+		Long: heredoc.Docf(`
+			Shows the time tracked against the time expected for today, this week, this month and since the day set on the config "%[1]s" (or --since), with the overtime (positive) or undertime (negative) balance of each of them. Only the days until today are considered.
+
+			The time expected on each day is set on the config "%[2]s", which can have different hours for some weekdays (like "8h,friday=4h"); days out of the config "%[3]s" are not expected to be worked, unless set on it.
+
+			Holidays and days off (see "clockify-cli time-off --help") are not expected to be worked, or only partially for half days and the ones with hours set.
+		`,
+			cmdutil.CONF_BALANCE_START, cmdutil.CONF_DAILY_HOURS,
+			cmdutil.CONF_WORKWEEK_DAYS,
+		),
+		Example: heredoc.Doc(`
+			$ clockify-cli balance --since 2026-10-01
+			+------------+------------+------------+-----------+-----------+-----------+
+			|   PERIOD   |   FIRST    |    LAST    |  TRACKED  | EXPECTED  |  BALANCE  |
+			+------------+------------+------------+-----------+-----------+-----------+
+			| today      | 2026-10-16 | 2026-10-16 | 6:30:00   | 8:00:00   | -1:30:00  |
+			| this-week  | 2026-10-11 | 2026-10-16 | 41:00:00  | 40:00:00  | +1:00:00  |
+			| this-month | 2026-10-01 | 2026-10-16 | 95:15:00  | 96:00:00  | -0:45:00  |
+			| since      | 2026-10-01 | 2026-10-16 | 95:15:00  | 96:00:00  | -0:45:00  |
+			+------------+------------+------------+-----------+-----------+-----------+
+
+			$ clockify-cli balance --json
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cnf := f.Config()
+			if !cmd.Flags().Changed("since") {
+				since = cnf.GetString(cmdutil.CONF_BALANCE_START)
+			}
+
+			today := timehlp.Today()
+			var rs []dayRange
+			for _, n := range []string{"today", "this-week", "this-month"} {
+				first, last, err := timehlp.GetNamedRange(n, today)
+				if err != nil {
+					return err
+				}
+				rs = append(rs, dayRange{name: n, first: first, last: last})
+			}
+
+			if since != "" {
+				s, err := time.ParseInLocation(
+					worktime.DateFormat, since, time.Local)
+				if err != nil {
+					return fmt.Errorf("since \"%s\" is invalid, "+
+						"use a date like \"2026-01-01\"", since)
+				}
+
+				if s.After(today) {
+					return fmt.Errorf("since \"%s\" is after today", since)
+				}
+
+				rs = append(rs, dayRange{name: "since", first: s, last: today})
+			}
+
+			first := today
+			for _, r := range rs {
+				if r.first.Before(first) {
+					first = r.first
+				}
+			}
+
+			cal, err := f.TimeOff()
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := c.LogRange(api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       first,
+				LastDate:        today.AddDate(0, 0, 1),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			t := worktime.Targets{Hours: cnf.DailyHoursOn, Calendar: cal}
+			now := timehlp.Now()
+			ps := make([]Period, len(rs))
+			for i, r := range rs {
+				if ps[i], err = balance(tes, t, r, now); err != nil {
+					return err
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			if asJSON {
+				return json.NewEncoder(out).Encode(ps)
+			}
+
+			report(out, ps)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day of the running balance (defaults to the config \""+
+			cmdutil.CONF_BALANCE_START+"\")")
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+
+	return cmd
+}
+
+type dayRange struct {
+	name        string
+	first, last time.Time
+}
+
+// balance sums the time tracked and expected from the first day of the range
+// until the last one, or today if it comes first
+func balance(
+	tes []dto.TimeEntry, t worktime.Targets, r dayRange, now time.Time,
+) (Period, error) {
+	first, last := r.first, r.last
+	today := time.Date(now.Year(), now.Month(), now.Day(),
+		0, 0, 0, 0, first.Location())
+	if last.After(today) {
+		last = today
+	}
+
+	expected, err := t.ExpectedBetween(first, last)
+	if err != nil {
+		return Period{}, err
+	}
+
+	next := last.AddDate(0, 0, 1)
+	var tracked time.Duration
+	for _, te := range tes {
+		s := te.TimeInterval.Start
+		if s.Before(first) || !s.Before(next) {
+			continue
+		}
+
+		e := now
+		if te.TimeInterval.End != nil {
+			e = *te.TimeInterval.End
+		}
+		tracked += e.Sub(s)
+	}
+
+	return Period{
+		Name:     r.name,
+		First:    first.Format(worktime.DateFormat),
+		Last:     last.Format(worktime.DateFormat),
+		Tracked:  dto.Duration{Duration: tracked},
+		Expected: dto.Duration{Duration: expected},
+		Balance:  dto.Duration{Duration: tracked - expected},
+	}, nil
+}
+
+func report(out io.Writer, ps []Period) {
+	tw := tablewriter.NewWriter(out)
+	tw.SetHeader([]string{
+		"Period", "First", "Last", "Tracked", "Expected", "Balance",
+	})
+
+	for _, p := range ps {
+		b := p.Balance.HumanString()
+		if p.Balance.Duration >= 0 {
+			b = "+" + b
+		}
+
+		tw.Append([]string{
+			p.Name,
+			p.First,
+			p.Last,
+			p.Tracked.HumanString(),
+			p.Expected.HumanString(),
+			b,
+		})
+	}
+
+	tw.Render()
+}
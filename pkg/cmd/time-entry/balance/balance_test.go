@@ -0,0 +1,131 @@
+package balance_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/balance"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdBalance(t *testing.T) {
+	today := timehlp.Today()
+	since := today.AddDate(0, 0, -40)
+	dayOff := today.AddDate(0, 0, -39)
+
+	tts := []struct {
+		name  string
+		args  []string
+		since string
+		err   string
+	}{
+		{
+			name: "invalid since",
+			args: []string{"--since", "01/01/2026"},
+			err: "since \"01/01/2026\" is invalid, " +
+				"use a date like \"2026-01-01\"",
+		},
+		{
+			name: "since after today",
+			args: []string{"--since",
+				today.AddDate(0, 0, 1).Format(worktime.DateFormat)},
+			err: "since \"" + today.AddDate(0, 0, 1).Format(
+				worktime.DateFormat) + "\" is after today",
+		},
+		{
+			name:  "since from config",
+			args:  []string{"--json"},
+			since: since.Format(worktime.DateFormat),
+		},
+		{
+			name: "since from flag",
+			args: []string{"-j", "--since", since.Format(worktime.DateFormat)},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.On("Config").Return(&mocks.SimpleConfig{
+				DailyHoursDuration: 8 * time.Hour,
+				WorkweekDays:       cmdutil.GetWeekdays(),
+				BalanceStart:       tt.since,
+			})
+
+			if tt.err == "" {
+				p := filepath.Join(t.TempDir(), "time-off.yaml")
+				if err := os.WriteFile(p, []byte("- date: "+
+					dayOff.Format(worktime.DateFormat)+"\n  hours: 2h\n"),
+					0644); err != nil {
+					t.Fatal(err)
+				}
+
+				f.On("TimeOff").Return(worktime.NewCalendar(p), nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("GetUserID").Return("u", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := since.Add(13 * time.Hour)
+				c.On("LogRange", mock.MatchedBy(func(p api.LogRangeParam) bool {
+					return p.Workspace == "w" && p.UserID == "u" &&
+						p.FirstDate.Equal(since) &&
+						p.LastDate.Equal(today.AddDate(0, 0, 1))
+				})).Return([]dto.TimeEntry{{
+					ID: "te1",
+					TimeInterval: dto.NewTimeInterval(
+						since.Add(9*time.Hour), &end),
+				}}, nil)
+			}
+
+			out := &bytes.Buffer{}
+			cmd := balance.NewCmdBalance(f)
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(out)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var ps []balance.Period
+			if !assert.NoError(t, json.Unmarshal(out.Bytes(), &ps)) {
+				return
+			}
+
+			if !assert.Len(t, ps, 4) {
+				return
+			}
+
+			assert.Equal(t, "today", ps[0].Name)
+			assert.Equal(t, 8*time.Hour, ps[0].Expected.Duration)
+
+			p := ps[3]
+			assert.Equal(t, "since", p.Name)
+			assert.Equal(t, since.Format(worktime.DateFormat), p.First)
+			assert.Equal(t, today.Format(worktime.DateFormat), p.Last)
+			assert.Equal(t, 4*time.Hour, p.Tracked.Duration)
+			assert.Equal(t, (40*8+2)*time.Hour, p.Expected.Duration)
+			assert.Equal(t, (4-40*8-2)*time.Hour, p.Balance.Duration)
+		})
+	}
+}
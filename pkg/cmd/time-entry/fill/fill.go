@@ -0,0 +1,298 @@
+package fill
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	tplutil "github.com/lucassabreu/clockify-cli/pkg/cmd/template/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdFill represents the fill command
+func NewCmdFill(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng      string
+		start    string
+		template string
+		dryRun   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "fill",
+		Args:  cobra.NoArgs,
+		Short: "Creates time entries to complete the hours expected on work days",
+		Long: heredoc.Docf(`
+			Creates time entries to complete the hours expected on each work day of a range.
+
+			For each day set on the config "%[1]s" (until now), the time tracked is compared with the config "%[2]s" (default is %[3]s) and the holidays and days off (see "clockify-cli time-off --help"), and the missing time is filled with new time entries on the gaps between the existing ones, starting at the beginning of the workday (--start).
+
+			The new time entries use the project, task, tags, description and custom fields of the template informed (see "clockify-cli template add --help"), and, if interactive mode is enabled, will ask for them for each time entry.
+
+			The time entries are always shown before being created, use --dry-run to only show them.
+
+			The ranges accepted are:
+		`,
+			cmdutil.CONF_WORKWEEK_DAYS, cmdutil.CONF_DAILY_HOURS,
+			cmdutil.DEFAULT_DAILY_HOURS,
+		) + cmdcompl.ValidArgsMap(timehlp.NamedRanges).Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli fill --range this-week --template coding --dry-run
+			+ 2026-10-12 09:00 - 12:30 (3:30:00) coding
+			+ 2026-10-13 16:00 - 17:00 (1:00:00) coding
+			2 time entries would be created
+
+			$ clockify-cli fill --start 08:00 -t coding
+			+ 2026-10-15 08:00 - 09:30 (1:30:00) coding
+			+ 2026-10-15 12:00 - 13:00 (1:00:00) coding
+			2 time entries were created
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ws, err := time.Parse("15:04", start)
+			if err != nil {
+				return fmt.Errorf(
+					"start \"%s\" is invalid, use a time like \"09:00\"",
+					start)
+			}
+
+			today := timehlp.Today()
+			first, last, err := timehlp.GetNamedRange(rng, today)
+			if err != nil {
+				return err
+			}
+
+			// future days can't be filled
+			if last.After(today) {
+				last = today
+			}
+
+			cnf := f.Config()
+			if len(cnf.GetWorkWeekdays()) == 0 {
+				return errors.New("no workweek days are set, use " +
+					"\"clockify-cli config set " + cmdutil.CONF_WORKWEEK_DAYS +
+					"\" to set them")
+			}
+
+			var tpl cmdutil.TimeEntryTemplate
+			if template != "" {
+				ts, err := cnf.GetTimeEntryTemplates()
+				if err != nil {
+					return err
+				}
+
+				i := tplutil.Search(ts, template)
+				if i == -1 {
+					return errors.New(
+						"template \"" + template + "\" not found")
+				}
+				tpl = ts[i]
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			logs, err := c.LogRange(api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       first,
+				LastDate:        last.AddDate(0, 0, 1),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			cal, err := f.TimeOff()
+			if err != nil {
+				return err
+			}
+
+			t := worktime.Targets{Hours: cnf.DailyHoursOn, Calendar: cal}
+			now := timehlp.Now()
+			var gs []timeentryhlp.Interval
+			for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+				expected, err := t.Expected(d)
+				if err != nil {
+					return err
+				}
+
+				if expected == 0 {
+					continue
+				}
+
+				next := d.AddDate(0, 0, 1)
+				until := next
+				if until.After(now) {
+					until = now
+				}
+
+				gs = append(gs, timeentryhlp.Gaps(
+					onDay(logs, d, next),
+					time.Date(d.Year(), d.Month(), d.Day(),
+						ws.Hour(), ws.Minute(), 0, 0, d.Location()),
+					expected,
+					until,
+				)...)
+			}
+
+			return fill(f, c, cmd.OutOrStdout(), fillParams{
+				Workspace: w,
+				UserID:    u,
+				Template:  tpl,
+				Gaps:      gs,
+				DryRun:    dryRun,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "today",
+		"range of days to fill")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range",
+		cmdcompl.ValidArgsMap(timehlp.NamedRanges))
+	cmd.Flags().StringVar(&start, "start", "09:00",
+		"time of the day the workday starts")
+	cmd.Flags().StringVarP(&template, "template", "t", "",
+		"template used for the new time entries")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "template",
+		func(*cobra.Command, []string, string) (cmdcompl.ValidArgs, error) {
+			return cmdcompl.ValidArgsSlide(tplutil.ValidArgs(f)), nil
+		})
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the time entries that would be created")
+
+	return cmd
+}
+
+// onDay returns the time entries that started on the day
+func onDay(tes []dto.TimeEntry, day, next time.Time) []dto.TimeEntry {
+	var ds []dto.TimeEntry
+	for _, te := range tes {
+		s := te.TimeInterval.Start
+		if !s.Before(day) && s.Before(next) {
+			ds = append(ds, te)
+		}
+	}
+
+	return ds
+}
+
+type fillParams struct {
+	Workspace string
+	UserID    string
+	Template  cmdutil.TimeEntryTemplate
+	Gaps      []timeentryhlp.Interval
+	DryRun    bool
+}
+
+func fill(f cmdutil.Factory, c api.Client, out io.Writer, p fillParams) error {
+	if len(p.Gaps) == 0 {
+		_, err := fmt.Fprintln(out, "there are no gaps to fill")
+		return err
+	}
+
+	base, err := util.Do(util.TimeEntryDTO{
+		Workspace:   p.Workspace,
+		UserID:      p.UserID,
+		ProjectID:   p.Template.Project,
+		Client:      p.Template.Client,
+		TaskID:      p.Template.Task,
+		Description: p.Template.Description,
+		TagIDs:      p.Template.Tags,
+		Billable:    p.Template.Billable,
+	}, util.GetAllowNameForIDsFn(f.Config(), c))
+	if err != nil {
+		return err
+	}
+
+	name := p.Template.Name
+	if name == "" {
+		name = "No Template"
+	}
+
+	for _, g := range p.Gaps {
+		if _, err := fmt.Fprintf(out, "+ %s %s - %s (%s) %s\n",
+			g.Start.Format("2006-01-02"),
+			g.Start.Format("15:04"), g.End.Format("15:04"),
+			dto.Duration{Duration: g.End.Sub(g.Start)}.HumanString(), name,
+		); err != nil {
+			return err
+		}
+	}
+
+	if p.DryRun {
+		_, err := fmt.Fprintf(out, "%d time entries would be created\n",
+			len(p.Gaps))
+		return err
+	}
+
+	cnf := f.Config()
+	if cnf.IsInteractive() {
+		ok, err := f.UI().Confirm(fmt.Sprintf(
+			"Create these %d time entries?", len(p.Gaps)), true)
+		if err != nil || !ok {
+			return err
+		}
+	}
+
+	dc := util.NewDescriptionCompleter(f)
+	for _, g := range p.Gaps {
+		end := g.End
+		te := base
+		te.Start = g.Start
+		te.End = &end
+
+		if te, err = util.Do(
+			te,
+			util.GetPropsInteractiveFn(dc, f),
+			util.GetValidateTimeEntryFn(f),
+			util.FillMissingBillableFn(c),
+		); err != nil {
+			return err
+		}
+
+		if _, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+			Workspace:    te.Workspace,
+			Start:        te.Start,
+			End:          te.End,
+			Billable:     te.Billable,
+			Description:  te.Description,
+			ProjectID:    te.ProjectID,
+			TaskID:       te.TaskID,
+			TagIDs:       te.TagIDs,
+			CustomFields: p.Template.CustomFieldValues(),
+		}); err != nil {
+			return err
+		}
+
+		// the answers of a time entry are used as default for the next
+		base.ProjectID = te.ProjectID
+		base.TaskID = te.TaskID
+		base.Description = te.Description
+		base.TagIDs = te.TagIDs
+	}
+
+	_, err = fmt.Fprintf(out, "%d time entries were created\n", len(p.Gaps))
+	return err
+}
@@ -0,0 +1,128 @@
+package fill_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/fill"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdFill(t *testing.T) {
+	b := true
+	yesterday := timehlp.Today().AddDate(0, 0, -1)
+	at := func(h int) time.Time {
+		return yesterday.Add(time.Duration(h) * time.Hour)
+	}
+
+	factory := func(t *testing.T) (*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{
+			AllowIncomplete:    true,
+			DailyHoursDuration: 4 * time.Hour,
+			WorkweekDays:       cmdutil.GetWeekdays(),
+			TimeEntryTemplates: []cmdutil.TimeEntryTemplate{{
+				Name:         "Coding",
+				Description:  "Coding",
+				Project:      "p1",
+				Billable:     &b,
+				CustomFields: map[string]string{"cf1": "a"},
+				Start:        "09:00",
+				Duration:     "1h",
+			}},
+		})
+		f.On("TimeOff").Return(worktime.NewCalendar(
+			filepath.Join(t.TempDir(), "time-off.yaml")), nil)
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+
+		end := at(12)
+		c.On("LogRange", api.LogRangeParam{
+			Workspace:       "w",
+			UserID:          "u",
+			FirstDate:       yesterday,
+			LastDate:        yesterday.AddDate(0, 0, 1),
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.TimeEntry{{
+			ID:           "te0",
+			TimeInterval: dto.NewTimeInterval(at(10), &end),
+		}}, nil)
+
+		return f, c
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		f, _ := factory(t)
+
+		out := &bytes.Buffer{}
+		cmd := fill.NewCmdFill(f)
+		cmd.SetArgs([]string{"-r", "yesterday", "-t", "coding", "--dry-run"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+
+		d := yesterday.Format("2006-01-02")
+		assert.Equal(t, heredoc.Docf(`
+			+ %[1]s 09:00 - 10:00 (1:00:00) Coding
+			+ %[1]s 12:00 - 13:00 (1:00:00) Coding
+			2 time entries would be created
+		`, d), out.String())
+	})
+
+	t.Run("creates time entries", func(t *testing.T) {
+		f, c := factory(t)
+
+		for _, h := range []int{9, 12} {
+			end := at(h + 1)
+			c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+				Workspace:    "w",
+				Start:        at(h),
+				End:          &end,
+				Billable:     &b,
+				Description:  "Coding",
+				ProjectID:    "p1",
+				CustomFields: []dto.CustomFieldValue{{CustomFieldID: "cf1", Value: "a"}},
+			}).Return(dto.TimeEntryImpl{}, nil).Once()
+		}
+
+		out := &bytes.Buffer{}
+		cmd := fill.NewCmdFill(f)
+		cmd.SetArgs([]string{"-r", "yesterday", "-t", "coding"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "\n2 time entries were created\n")
+	})
+
+	t.Run("requires workweek days", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{})
+
+		cmd := fill.NewCmdFill(f)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "no workweek days are set, use "+
+			"\"clockify-cli config set workweek-days\" to set them")
+	})
+}
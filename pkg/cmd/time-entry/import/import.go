@@ -0,0 +1,444 @@
+package imp
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdImport represents the import command
+func NewCmdImport(f cmdutil.Factory) *cobra.Command {
+	var (
+		from    string
+		mapping string
+		dryRun  bool
+		force   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import { <file> | - }...",
+		Args:  cmdutil.RequiredNamedArgs("file"),
+		Short: "Imports time entries from files or other time trackers",
+		Long: heredoc.Docf(`
+			Imports time entries from CSV or JSON files, or from exports of Toggl Track, Harvest and Timewarrior, creating each one of them on Clockify.
+
+			The format of the files is detected by their extension (for CSV and JSON), or can be set with --from (required when reading from stdin using "-"). The formats supported are:
+			  csv: the columns are described below
+			  json: the fields are described below
+			  toggl: the detailed CSV export of Toggl Track
+			  harvest: the detailed time report CSV export of Harvest, as it does not have when the entries started, the ones of each day are placed one after the other starting at 09:00
+			  timewarrior: the output of "timew export", the annotation is used as description
+
+			CSV files must have a header line, and the columns recognized are: description, project.id, project.name (or project), client.name (or client), task.id, task.name (or task), start, end, duration, billable and tags... (or tags, separated by ";"); the output of --csv can be imported.
+
+			JSON files must have a list of time entries, with the fields: description, project, client, task, tags, billable, start, end and duration; the output of --json can be imported.
+
+			Start and end must be RFC 3339 or "%s", and when end is not informed the duration is used ("1:30:00", "1h30m" or "PT1H30M").
+
+			Projects, tasks and tags can be informed by their IDs or names. To use names different from the ones on the files, use --mapping with a YAML file like:
+			  projects:
+			    Old Project: New Project
+			    timew-tag: Project From Tag
+			  clients:
+			    Old Client: New Client
+			  tags:
+			    old-tag: new-tag
+			    ignored-tag: ""
+			Tags mapped to "" are dropped, and tags mapped as projects set the project of the time entries without one (useful for Timewarrior).
+
+			Every time entry imported is recorded on a local ledger (~/.config/clockify-cli/import-ledger), so importing the same file again will skip the ones already imported; use --force to import them anyway.
+
+			Each time entry is validated using the rules of the workspace before being created, and time entries that fail to be read or validated are reported without stopping the others from being imported.
+
+			Use --dry-run to see the time entries that would be created.
+		`, output.TimeFormatFull),
+		Example: heredoc.Doc(`
+			$ cat entries.csv
+			start,end,project,task,description,tags
+			2026-10-14 09:00,2026-10-14 10:30,website,layout,Planning,meeting;dev
+			2026-10-14 11:00,,website,,Review,
+
+			$ clockify-cli import entries.csv --dry-run
+			+ line 2: 2026-10-14 09:00:00 - 10:30:00 (1:30:00) website / layout: Planning [meeting, dev]
+			! line 3: end or duration is required
+			1 time entries would be imported
+			Error: 1 of 2 time entries failed to be imported
+
+			$ clockify-cli report --json | clockify-cli import - --from json
+
+			$ timew export | clockify-cli import - --from timewarrior --mapping timew.yaml
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			es := make([]importer.Entry, 0)
+			for _, name := range args {
+				l, err := readFile(cmd.InOrStdin(), name, from)
+				if err != nil {
+					return err
+				}
+				es = append(es, l...)
+			}
+
+			m := importer.Mapping{}
+			if mapping != "" {
+				var err error
+				if m, err = importer.ReadMapping(mapping); err != nil {
+					return err
+				}
+			}
+
+			return Import(f, cmd.OutOrStdout(), es, Options{
+				Mapping: m,
+				DryRun:  dryRun,
+				Force:   force,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "",
+		"format of the files (defaults to the file extension)")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "from",
+		cmdcompl.ValidArgsSlide(importer.Formats()))
+	cmd.Flags().StringVarP(&mapping, "mapping", "m", "",
+		"YAML file mapping the names of projects, clients and tags")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the time entries that would be imported")
+	cmd.Flags().BoolVar(&force, "force", false,
+		"import time entries even if they were already imported")
+
+	return cmd
+}
+
+// Options sets how the entries are imported
+type Options struct {
+	// Mapping translates the names of projects, clients and tags
+	Mapping importer.Mapping
+	// DryRun only shows the time entries that would be created
+	DryRun bool
+	// Force imports entries already recorded on the ledger
+	Force bool
+}
+
+// Import creates the time entries of the entries read from files, printing
+// the result of each one into out. Entries that fail are reported without
+// stopping the others, and a error is returned at the end if any failed
+func Import(
+	f cmdutil.Factory, out io.Writer, es []importer.Entry, o Options,
+) error {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	u, err := f.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	l, err := f.ImportLedger()
+	if err != nil {
+		return err
+	}
+
+	r := newResolver(c, f.Config(), w)
+	steps := []util.Step{r.resolve, util.GetValidateTimeEntryFn(f)}
+	if !o.DryRun {
+		steps = append(steps, util.CreateTimeEntryFn(c))
+	}
+
+	i := run{
+		out:       out,
+		workspace: w,
+		user:      u,
+		steps:     steps,
+		mapping:   o.Mapping,
+		ledger:    l,
+		dryRun:    o.DryRun,
+		force:     o.Force,
+	}
+
+	failed, skipped := 0, 0
+	for _, e := range es {
+		r, err := i.importEntry(e)
+		if err != nil {
+			return err
+		}
+
+		switch r {
+		case resultSkipped:
+			skipped++
+		case resultFailed:
+			failed++
+		}
+	}
+
+	verb := "were"
+	if o.DryRun {
+		verb = "would be"
+	}
+
+	if _, err := fmt.Fprintf(out, "%d time entries %s imported",
+		len(es)-failed-skipped, verb); err != nil {
+		return err
+	}
+
+	if skipped > 0 {
+		if _, err := fmt.Fprintf(
+			out, ", %d already imported", skipped); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d time entries failed to be imported",
+			failed, len(es))
+	}
+
+	return nil
+}
+
+func readFile(stdin io.Reader, name, from string) ([]importer.Entry, error) {
+	f := importer.Format(from)
+	if from == "" {
+		if name == "-" {
+			return nil, cmdutil.FlagErrorWrap(fmt.Errorf(
+				"--from is required when reading from stdin"))
+		}
+
+		var err error
+		if f, err = importer.FormatFromFilename(name); err != nil {
+			return nil, err
+		}
+	}
+
+	r := stdin
+	if name != "-" {
+		fh, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer fh.Close()
+		r = fh
+	}
+
+	es, err := importer.Parse(f, r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return es, nil
+}
+
+// run keeps what is needed to import each entry
+type run struct {
+	out       io.Writer
+	workspace string
+	user      string
+	steps     []util.Step
+	mapping   importer.Mapping
+	ledger    *importer.Ledger
+	dryRun    bool
+	force     bool
+}
+
+// result is what happened to a entry while importing it
+type result int
+
+const (
+	resultImported result = iota
+	resultSkipped
+	resultFailed
+)
+
+// importEntry runs the steps over the entry, printing a line with its
+// result. The error is only returned when that line can't be printed.
+func (i run) importEntry(e importer.Entry) (result, error) {
+	if e.Err != nil {
+		return i.fail(e.Line, e.Err)
+	}
+
+	e = i.mapping.Apply(e)
+
+	if !i.force && e.Key != "" {
+		r, ok, err := i.ledger.Get(i.workspace, e.Key)
+		if err != nil {
+			return i.fail(e.Line, err)
+		}
+
+		if ok {
+			_, err := fmt.Fprintf(i.out,
+				"= line %d: already imported as %s\n", e.Line, r.TimeEntryID)
+			return resultSkipped, err
+		}
+	}
+
+	end := e.End
+	te, err := util.Do(util.TimeEntryDTO{
+		Workspace:   i.workspace,
+		UserID:      i.user,
+		Description: e.Description,
+		ProjectID:   e.Project,
+		Client:      e.Client,
+		TaskID:      e.Task,
+		TagIDs:      e.Tags,
+		Billable:    e.Billable,
+		Start:       e.Start,
+		End:         &end,
+	}, i.steps...)
+	if err != nil {
+		return i.fail(e.Line, err)
+	}
+
+	if _, err := fmt.Fprintf(
+		i.out, "+ line %d: %s\n", e.Line, describe(e)); err != nil {
+		return resultImported, err
+	}
+
+	if i.dryRun || e.Key == "" {
+		return resultImported, nil
+	}
+
+	if err := i.ledger.Add(i.workspace, e.Key, te.ID); err != nil {
+		_, wErr := fmt.Fprintf(i.out, "! line %d: imported as %s, but %s\n",
+			e.Line, te.ID, err)
+		return resultFailed, wErr
+	}
+
+	return resultImported, nil
+}
+
+// fail prints why the entry at line could not be imported
+func (i run) fail(line int, err error) (result, error) {
+	_, wErr := fmt.Fprintf(i.out, "! line %d: %s\n", line, err)
+	return resultFailed, wErr
+}
+
+// describe shows a entry as it was read from the file
+func describe(e importer.Entry) string {
+	s := e.Start.Format(output.TimeFormatFull) + " - "
+	if e.End.Format("2006-01-02") == e.Start.Format("2006-01-02") {
+		s += e.End.Format(output.TimeFormatSimple)
+	} else {
+		s += e.End.Format(output.TimeFormatFull)
+	}
+
+	s += " (" + dto.Duration{Duration: e.End.Sub(e.Start)}.HumanString() + ")"
+
+	if e.Project != "" {
+		s += " " + e.Project
+		if e.Task != "" {
+			s += " / " + e.Task
+		}
+		s += ":"
+	}
+
+	if e.Description != "" {
+		s += " " + e.Description
+	}
+
+	if len(e.Tags) > 0 {
+		s += " [" + strings.Join(e.Tags, ", ") + "]"
+	}
+
+	return s
+}
+
+// resolver looks up the IDs of the projects, tasks and tags using their
+// names, remembering the ones already found
+type resolver struct {
+	c         api.Client
+	config    cmdutil.Config
+	workspace string
+	ids       map[string]string
+}
+
+func newResolver(
+	c api.Client, config cmdutil.Config, workspace string) *resolver {
+	return &resolver{
+		c:         c,
+		config:    config,
+		workspace: workspace,
+		ids:       map[string]string{},
+	}
+}
+
+func (r *resolver) lookup(
+	key string, fn func() (string, error)) (string, error) {
+	if id, ok := r.ids[key]; ok {
+		return id, nil
+	}
+
+	id, err := fn()
+	if err != nil {
+		return id, err
+	}
+
+	r.ids[key] = id
+	return id, nil
+}
+
+func (r *resolver) resolve(te util.TimeEntryDTO) (util.TimeEntryDTO, error) {
+	var err error
+	if te.ProjectID != "" {
+		ref := te.ProjectID
+		if te.ProjectID, err = r.lookup(
+			"project\x00"+te.Client+"\x00"+ref,
+			func() (string, error) {
+				return search.GetProjectByName(
+					r.c, r.config, r.workspace, ref, te.Client)
+			},
+		); err != nil {
+			return te, err
+		}
+	}
+
+	if te.TaskID != "" {
+		ref := te.TaskID
+		if te.TaskID, err = r.lookup(
+			"task\x00"+te.ProjectID+"\x00"+ref,
+			func() (string, error) {
+				return search.GetTaskByName(r.c, api.GetTasksParam{
+					Workspace: r.workspace,
+					ProjectID: te.ProjectID,
+				}, ref)
+			},
+		); err != nil {
+			return te, err
+		}
+	}
+
+	tags := make([]string, len(te.TagIDs))
+	for i, ref := range te.TagIDs {
+		if tags[i], err = r.lookup(
+			"tag\x00"+ref,
+			func() (string, error) {
+				return search.GetTagByName(r.c, r.workspace, ref)
+			},
+		); err != nil {
+			return te, err
+		}
+	}
+	te.TagIDs = tags
+
+	return te, nil
+}
@@ -0,0 +1,176 @@
+package imp_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	imp "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+const entriesCSV = `start,end,project,task,description,tags
+2026-10-14 09:00,2026-10-14 10:30,website,layout,Planning,dev
+2026-10-14 11:00,2026-10-14 12:00,unknown,,Review,
+2026-10-14 13:00,,website,,Broken,
+2026-10-14 14:00,2026-10-14 15:00,website,,Coding,dev
+`
+
+func local(s string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	return t
+}
+
+func TestCmdImport(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "entries.csv")
+	if err := os.WriteFile(file, []byte(entriesCSV), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	factory := func(t *testing.T) (*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("ImportLedger").Return(importer.NewLedger(
+			filepath.Join(t.TempDir(), "import-ledger")), nil)
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+		f.On("GetWorkspace").Return(dto.Workspace{ID: "w"}, nil)
+
+		cf := mocks.NewMockConfig(t)
+		f.On("Config").Return(cf)
+		cf.On("GetBool", cmdutil.CONF_ALLOW_INCOMPLETE).Return(false)
+		cf.On("IsSearchProjectWithClientsName").Return(false)
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+
+		c.On("GetProjects", api.GetProjectsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Project{{ID: "p1", Name: "Website"}}, nil)
+		c.On("GetProject", api.GetProjectParam{
+			Workspace: "w",
+			ProjectID: "p1",
+		}).Return(&dto.Project{ID: "p1", Name: "Website"}, nil)
+		c.On("GetTasks", api.GetTasksParam{
+			Workspace:       "w",
+			ProjectID:       "p1",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Task{{ID: "t1", Name: "Layout"}}, nil).Once()
+		c.On("GetTags", api.GetTagsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Tag{{ID: "tag1", Name: "Dev"}}, nil).Once()
+
+		return f, c
+	}
+
+	t.Run("stdin requires from", func(t *testing.T) {
+		cmd := imp.NewCmdImport(mocks.NewMockFactory(t))
+		cmd.SetArgs([]string{"-"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "--from is required when reading from stdin")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		f, _ := factory(t)
+
+		b := &bytes.Buffer{}
+		cmd := imp.NewCmdImport(f)
+		cmd.SetArgs([]string{file, "--dry-run"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(b)
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "2 of 4 time entries failed to be imported")
+		assert.Equal(t, heredoc.Doc(`
+			+ line 2: 2026-10-14 09:00:00 - 10:30:00 (1:30:00) website / layout: Planning [dev]
+			! line 3: No project with id or name containing 'unknown' was found
+			! line 4: end or duration is required
+			+ line 5: 2026-10-14 14:00:00 - 15:00:00 (1:00:00) website: Coding [dev]
+			2 time entries would be imported
+		`), b.String())
+	})
+
+	t.Run("creates the valid time entries", func(t *testing.T) {
+		f, c := factory(t)
+
+		end := local("2026-10-14 10:30")
+		c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+			Workspace:   "w",
+			Start:       local("2026-10-14 09:00"),
+			End:         &end,
+			Description: "Planning",
+			ProjectID:   "p1",
+			TaskID:      "t1",
+			TagIDs:      []string{"tag1"},
+		}).Return(dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+
+		end2 := local("2026-10-14 15:00")
+		c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+			Workspace:   "w",
+			Start:       local("2026-10-14 14:00"),
+			End:         &end2,
+			Description: "Coding",
+			ProjectID:   "p1",
+			TagIDs:      []string{"tag1"},
+		}).Return(dto.TimeEntryImpl{ID: "te2"}, nil).Once()
+
+		b := &bytes.Buffer{}
+		cmd := imp.NewCmdImport(f)
+		cmd.SetArgs([]string{"-", "--from", "csv"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetIn(strings.NewReader(entriesCSV))
+		cmd.SetOut(b)
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "2 of 4 time entries failed to be imported")
+		assert.Contains(t, b.String(), "2 time entries were imported\n")
+
+		b.Reset()
+		cmd.SetArgs([]string{"-", "--from", "csv"})
+		cmd.SetIn(strings.NewReader(entriesCSV))
+
+		_, err = cmd.ExecuteC()
+		assert.EqualError(t, err, "2 of 4 time entries failed to be imported")
+		assert.Contains(t, b.String(),
+			"= line 2: already imported as te1\n")
+		assert.Contains(t, b.String(),
+			"0 time entries were imported, 2 already imported\n")
+	})
+
+	t.Run("mapping", func(t *testing.T) {
+		f, _ := factory(t)
+
+		mapping := filepath.Join(dir, "mapping.yaml")
+		_ = os.WriteFile(mapping, []byte("projects:\n  unknown: website\n"),
+			0644)
+
+		b := &bytes.Buffer{}
+		cmd := imp.NewCmdImport(f)
+		cmd.SetArgs([]string{file, "--dry-run", "--mapping", mapping})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(b)
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "1 of 4 time entries failed to be imported")
+		assert.Contains(t, b.String(),
+			"+ line 3: 2026-10-14 11:00:00 - 12:00:00 (1:00:00) website: Review\n")
+	})
+}
@@ -0,0 +1,344 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// Kinds of problems found on the time entries
+const (
+	KindOverlap            = "overlap"
+	KindTooLong            = "too-long"
+	KindCrossesMidnight    = "crosses-midnight"
+	KindMissingProject     = "missing-project"
+	KindMissingTask        = "missing-task"
+	KindMissingTags        = "missing-tags"
+	KindMissingDescription = "missing-description"
+	KindArchivedProject    = "archived-project"
+	KindGap                = "gap"
+)
+
+// Finding is a problem found on a time entry, or on a gap between them
+type Finding struct {
+	Kind        string    `json:"kind"`
+	TimeEntryID string    `json:"timeEntryId,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Message     string    `json:"message"`
+}
+
+// Options sets which problems are reported
+type Options struct {
+	MaxDuration  time.Duration
+	WorkdayStart time.Duration
+	WorkdayEnd   time.Duration
+	MinGap       time.Duration
+	Workweek     []string
+	Settings     dto.WorkspaceSettings
+}
+
+// NewCmdLint represents the lint command
+func NewCmdLint(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng        string
+		asJSON     bool
+		quiet      bool
+		start, end string
+		o          Options
+	)
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Args:  cobra.NoArgs,
+		Short: "Checks the time entries of a range for problems",
+		Long: heredoc.Docf(`
+			Checks the time entries of a range for problems, exiting with a non-zero code if any is found.
+
+			The problems reported are:
+			- %[1]s: time entries sharing part of their time
+			- %[2]s: time entries longer than --max-duration
+			- %[3]s: time entries ending on a day after their start
+			- %[4]s, %[5]s, %[6]s and %[7]s: time entries without a value the workspace requires
+			- %[8]s: time entries of archived projects
+			- %[9]s: time without time entries longer than --min-gap, between --start and --end of the days set on the config "%[10]s"
+
+			The ranges accepted are:
+		`,
+			KindOverlap, KindTooLong, KindCrossesMidnight,
+			KindMissingProject, KindMissingTask, KindMissingTags,
+			KindMissingDescription, KindArchivedProject, KindGap,
+			cmdutil.CONF_WORKWEEK_DAYS,
+		) + cmdcompl.ValidArgsMap(timehlp.NamedRanges).Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli lint --range last-week
+			2026-10-05 12:00 - 13:00 gap: no time entries for 1:00:00
+			2026-10-06 10:00 - 10:30 overlap 62b87a9785815e619d7ce02e: overlaps with 62b87ab285815e619d7ce0a1 for 0:30:00
+			2026-10-08 14:00 - 15:00 missing-task 62b87b1385815e619d7ce11f: workspace requires task
+			2026-10-09 18:00 - 2026-10-10 02:00 crosses-midnight 62b87b4285815e619d7ce171: ends on another day
+			Error: 4 problems were found
+
+			$ clockify-cli lint --json
+			[{"kind":"gap","start":"2026-10-14T12:00:00-03:00","end":"2026-10-14T13:00:00-03:00","message":"no time entries for 1:00:00"}]
+			Error: 1 problems were found
+
+			# only sets the exit code
+			$ clockify-cli lint --quiet --range today
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"json":  asJSON,
+				"quiet": quiet,
+			}); err != nil {
+				return err
+			}
+
+			var err error
+			if o.WorkdayStart, err = timeOfDay("start", start); err != nil {
+				return err
+			}
+
+			if o.WorkdayEnd, err = timeOfDay("end", end); err != nil {
+				return err
+			}
+
+			first, last, err := timehlp.GetNamedRange(rng, timehlp.Today())
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspace()
+			if err != nil {
+				return err
+			}
+			o.Settings = w.Settings
+			o.Workweek = f.Config().GetWorkWeekdays()
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := c.LogRange(api.LogRangeParam{
+				Workspace:       w.ID,
+				UserID:          u,
+				FirstDate:       first,
+				LastDate:        last.AddDate(0, 0, 1),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			fs := Lint(tes, first, last, timehlp.Now(), o)
+
+			out := cmd.OutOrStdout()
+			switch {
+			case asJSON:
+				if fs == nil {
+					fs = []Finding{}
+				}
+				err = json.NewEncoder(out).Encode(fs)
+			case quiet:
+			default:
+				err = report(out, fs)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(fs) > 0 {
+				return fmt.Errorf("%d problems were found", len(fs))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "this-week",
+		"range of days to check")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range",
+		cmdcompl.ValidArgsMap(timehlp.NamedRanges))
+	cmd.Flags().DurationVar(&o.MaxDuration, "max-duration", 10*time.Hour,
+		"longest duration a time entry may have")
+	cmd.Flags().StringVar(&start, "start", "09:00",
+		"time of the day the workday starts")
+	cmd.Flags().StringVar(&end, "end", "18:00",
+		"time of the day the workday ends")
+	cmd.Flags().DurationVar(&o.MinGap, "min-gap", 15*time.Minute,
+		"shortest gap during the workday to be reported")
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false,
+		"don't print the problems, only set the exit code")
+
+	return cmd
+}
+
+func timeOfDay(name, v string) (time.Duration, error) {
+	t, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"%s \"%s\" is invalid, use a time like \"09:00\"", name, v)
+	}
+
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Lint returns the problems found on the time entries between the first and
+// last days, sorted by when they happen. Nothing after now is checked
+func Lint(
+	tes []dto.TimeEntry, first, last, now time.Time, o Options,
+) []Finding {
+	var fs []Finding
+	for _, ol := range timeentryhlp.Overlaps(tes, now) {
+		fs = append(fs, Finding{
+			Kind:        KindOverlap,
+			TimeEntryID: ol.First.ID,
+			Start:       ol.Start,
+			End:         ol.End,
+			Message: "overlaps with " + ol.Second.ID + " for " +
+				duration(ol.End.Sub(ol.Start)),
+		})
+	}
+
+	for _, te := range tes {
+		fs = append(fs, lintTimeEntry(te, now, o)...)
+	}
+
+	for d := first; !d.After(last) && d.Before(now); d = d.AddDate(0, 0, 1) {
+		wd := strings.ToLower(d.Weekday().String())
+		if !strhlp.InSlice(wd, o.Workweek) {
+			continue
+		}
+
+		from := d.Add(o.WorkdayStart)
+		to := d.Add(o.WorkdayEnd)
+		if to.After(now) {
+			to = now
+		}
+
+		if !to.After(from) {
+			continue
+		}
+
+		for _, h := range timeentryhlp.Holes(tes, from, to) {
+			if h.End.Sub(h.Start) < o.MinGap {
+				continue
+			}
+
+			fs = append(fs, Finding{
+				Kind:    KindGap,
+				Start:   h.Start,
+				End:     h.End,
+				Message: "no time entries for " + duration(h.End.Sub(h.Start)),
+			})
+		}
+	}
+
+	sort.SliceStable(fs, func(i, j int) bool {
+		return fs[i].Start.Before(fs[j].Start)
+	})
+
+	return fs
+}
+
+func lintTimeEntry(te dto.TimeEntry, now time.Time, o Options) []Finding {
+	s := te.TimeInterval.Start
+	e := now
+	if te.TimeInterval.End != nil {
+		e = *te.TimeInterval.End
+	}
+
+	var fs []Finding
+	add := func(kind, message string) {
+		fs = append(fs, Finding{
+			Kind:        kind,
+			TimeEntryID: te.ID,
+			Start:       s,
+			End:         e,
+			Message:     message,
+		})
+	}
+
+	if o.MaxDuration > 0 && e.Sub(s) > o.MaxDuration {
+		add(KindTooLong, "lasts "+duration(e.Sub(s))+", more than "+
+			duration(o.MaxDuration))
+	}
+
+	ls, le := s.In(time.Local), e.In(time.Local)
+	if le.After(ls) && ls.Format("2006-01-02") !=
+		le.Add(-time.Nanosecond).Format("2006-01-02") {
+		add(KindCrossesMidnight, "ends on another day")
+	}
+
+	if o.Settings.ForceProjects && te.ProjectID == "" {
+		add(KindMissingProject, "workspace requires project")
+	}
+
+	if o.Settings.ForceTasks && te.Task == nil {
+		add(KindMissingTask, "workspace requires task")
+	}
+
+	if o.Settings.ForceTags && len(te.Tags) == 0 {
+		add(KindMissingTags, "workspace requires at least one tag")
+	}
+
+	if o.Settings.ForceDescription && strings.TrimSpace(te.Description) == "" {
+		add(KindMissingDescription, "workspace requires description")
+	}
+
+	if te.Project != nil && te.Project.Archived {
+		add(KindArchivedProject, "project "+te.Project.Name+" is archived")
+	}
+
+	return fs
+}
+
+func duration(d time.Duration) string {
+	return dto.Duration{Duration: d}.HumanString()
+}
+
+func report(out io.Writer, fs []Finding) error {
+	for _, f := range fs {
+		s := f.Start.In(time.Local)
+		e := f.End.In(time.Local)
+
+		ef := "15:04"
+		if s.Format("2006-01-02") != e.Format("2006-01-02") {
+			ef = "2006-01-02 15:04"
+		}
+
+		id := ""
+		if f.TimeEntryID != "" {
+			id = " " + f.TimeEntryID
+		}
+
+		if _, err := fmt.Fprintf(out, "%s - %s %s%s: %s\n",
+			s.Format("2006-01-02 15:04"), e.Format(ef), f.Kind, id, f.Message,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/lint"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	day := time.Date(2026, 10, 14, 0, 0, 0, 0, time.Local)
+	at := func(d, h, m int) time.Time {
+		return day.AddDate(0, 0, d).Add(
+			time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+	}
+	te := func(id string, s, e time.Time) dto.TimeEntry {
+		return dto.TimeEntry{
+			ID:           id,
+			Description:  "something",
+			ProjectID:    "p1",
+			Project:      &dto.Project{ID: "p1", Name: "Website"},
+			TimeInterval: dto.NewTimeInterval(s, &e),
+		}
+	}
+
+	noDescription := te("te3", at(0, 12, 0), at(0, 13, 0))
+	noDescription.Description = " "
+
+	archived := te("te4", at(0, 13, 0), at(0, 17, 0))
+	archived.Project = &dto.Project{ID: "p2", Name: "Old", Archived: true}
+
+	fs := lint.Lint([]dto.TimeEntry{
+		te("te1", at(0, 9, 0), at(0, 10, 30)),
+		te("te2", at(0, 10, 0), at(0, 11, 0)),
+		noDescription,
+		archived,
+		te("te5", at(0, 22, 0), at(1, 9, 0)),
+	}, day, day.AddDate(0, 0, 1), at(1, 12, 0), lint.Options{
+		MaxDuration:  10 * time.Hour,
+		WorkdayStart: 9 * time.Hour,
+		WorkdayEnd:   18 * time.Hour,
+		MinGap:       15 * time.Minute,
+		Workweek:     []string{"wednesday"},
+		Settings:     dto.WorkspaceSettings{ForceDescription: true},
+	})
+
+	kinds := make([]string, len(fs))
+	for i := range fs {
+		kinds[i] = fs[i].Kind + " " + fs[i].TimeEntryID
+	}
+
+	assert.Equal(t, []string{
+		"overlap te1",
+		"gap ",
+		"missing-description te3",
+		"archived-project te4",
+		"gap ",
+		"too-long te5",
+		"crosses-midnight te5",
+	}, kinds)
+
+	assert.Equal(t, at(0, 10, 0).UTC(), fs[0].Start.UTC())
+	assert.Equal(t, at(0, 10, 30).UTC(), fs[0].End.UTC())
+	assert.Equal(t, "overlaps with te2 for 0:30:00", fs[0].Message)
+
+	assert.Equal(t, at(0, 11, 0).UTC(), fs[1].Start.UTC())
+	assert.Equal(t, at(0, 12, 0).UTC(), fs[1].End.UTC())
+	assert.Equal(t, at(0, 17, 0).UTC(), fs[4].Start.UTC())
+	assert.Equal(t, at(0, 18, 0).UTC(), fs[4].End.UTC())
+	assert.Equal(t, "lasts 11:00:00, more than 10:00:00", fs[5].Message)
+}
+
+func TestCmdLint(t *testing.T) {
+	today := timehlp.Today()
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetWorkspace").Return(dto.Workspace{ID: "w",
+		Settings: dto.WorkspaceSettings{ForceTasks: true}}, nil)
+	f.On("GetUserID").Return("u", nil)
+	f.On("Config").Return(&mocks.SimpleConfig{})
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	end := today.Add(time.Hour)
+	c.On("LogRange", api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       today,
+		LastDate:        today.AddDate(0, 0, 1),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{{
+		ID:           "te1",
+		TimeInterval: dto.NewTimeInterval(today, &end),
+	}}, nil)
+
+	out := &bytes.Buffer{}
+	cmd := lint.NewCmdLint(f)
+	cmd.SetArgs([]string{"--range", "today", "--json"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetOut(out)
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "1 problems were found")
+
+	var fs []lint.Finding
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &fs))
+	if assert.Len(t, fs, 1) {
+		assert.Equal(t, lint.KindMissingTask, fs[0].Kind)
+		assert.Equal(t, "te1", fs[0].TimeEntryID)
+	}
+}
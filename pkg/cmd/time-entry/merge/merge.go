@@ -0,0 +1,68 @@
+package merge
+
+import (
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdMerge represents the merge command
+func NewCmdMerge(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng    string
+		maxGap time.Duration
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "merge",
+		Args:  cobra.NoArgs,
+		Short: "Combines adjacent time entries of the same activity",
+		Long: heredoc.Doc(`
+			Combines time entries with the same project, task, description, tags, billable status and custom fields that are next to each other (or apart by --max-gap or less) into the first of them, which is extended to the end of the last one; the others are deleted.
+
+			Running and locked time entries are not merged.
+
+			The changes are always shown before being made, use --dry-run to only show them.
+
+			The ranges accepted are:
+		`) + cmdcompl.ValidArgsMap(timehlp.NamedRanges).Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli merge --range yesterday --max-gap 5m
+			~ 62b87a9785815e619d7ce02e 2026-10-16 09:00 - 10:00 => 2026-10-16 09:00 - 11:30: merged with 1 time entries
+			- 62b87ab285815e619d7ce0a1 2026-10-16 10:03 - 11:30: merged into 62b87a9785815e619d7ce02e
+			1 time entries were updated, 1 deleted
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := util.LogNamedRange(f, c, rng)
+			if err != nil {
+				return err
+			}
+
+			return util.ApplyChanges(cmd.Context(), f, c, cmd.OutOrStdout(),
+				timeentryhlp.Merges(tes, maxGap), dryRun)
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "today",
+		"range of days to merge")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range",
+		cmdcompl.ValidArgsMap(timehlp.NamedRanges))
+	cmd.Flags().DurationVar(&maxGap, "max-gap", 0,
+		"longest time between two time entries to merge them")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the changes that would be made")
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package merge_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/merge"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdMerge(t *testing.T) {
+	yesterday := timehlp.Today().AddDate(0, 0, -1)
+	at := func(h, m int) time.Time {
+		return yesterday.Add(
+			time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+	}
+	te := func(id string, s, e time.Time) dto.TimeEntry {
+		return dto.TimeEntry{
+			ID:           id,
+			WorkspaceID:  "w",
+			Billable:     true,
+			Description:  "Coding",
+			ProjectID:    "p1",
+			Task:         &dto.Task{ID: "tk1"},
+			Tags:         []dto.Tag{{ID: "tg1"}},
+			TimeInterval: dto.NewTimeInterval(s, &e),
+		}
+	}
+
+	factory := func(t *testing.T) (*mocks.MockFactory, *mocks.MockClient) {
+		f := mocks.NewMockFactory(t)
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+
+		c := mocks.NewMockClient(t)
+		f.On("Client").Return(c, nil)
+
+		c.On("LogRange", api.LogRangeParam{
+			Workspace:       "w",
+			UserID:          "u",
+			FirstDate:       yesterday,
+			LastDate:        yesterday.AddDate(0, 0, 1),
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.TimeEntry{
+			te("te1", at(9, 0), at(10, 0)),
+			te("te2", at(10, 3), at(11, 30)),
+			te("te3", at(13, 0), at(14, 0)),
+		}, nil)
+
+		return f, c
+	}
+
+	run := func(f *mocks.MockFactory, args ...string) (string, error) {
+		out := &bytes.Buffer{}
+		cmd := merge.NewCmdMerge(f)
+		cmd.SetArgs(append([]string{"-r", "yesterday", "--max-gap", "5m"},
+			args...))
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		return out.String(), err
+	}
+
+	day := yesterday.Format("2006-01-02")
+	preview := heredoc.Docf(`
+		~ te1 %[1]s 09:00 - 10:00 => %[1]s 09:00 - 11:30: merged with 1 time entries
+		- te2 %[1]s 10:03 - 11:30: merged into te1
+	`, day)
+
+	t.Run("dry-run", func(t *testing.T) {
+		f, _ := factory(t)
+
+		out, err := run(f, "--dry-run")
+		assert.NoError(t, err)
+		assert.Equal(t,
+			preview+"1 time entries would be updated, 1 deleted\n", out)
+	})
+
+	t.Run("merges", func(t *testing.T) {
+		f, c := factory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{})
+		c.EXPECT().WithContext(mock.Anything).Return(c)
+
+		end := at(11, 30).UTC()
+		c.On("UpdateTimeEntry", api.UpdateTimeEntryParam{
+			Workspace:   "w",
+			TimeEntryID: "te1",
+			Start:       at(9, 0).UTC(),
+			End:         &end,
+			Billable:    true,
+			Description: "Coding",
+			ProjectID:   "p1",
+			TaskID:      "tk1",
+			TagIDs:      []string{"tg1"},
+		}).Return(dto.TimeEntryImpl{}, nil).Once()
+		c.On("DeleteTimeEntry", api.DeleteTimeEntryParam{
+			Workspace:   "w",
+			TimeEntryID: "te2",
+		}).Return(nil).Once()
+
+		out, err := run(f)
+		assert.NoError(t, err)
+		assert.Equal(t,
+			preview+"1 time entries were updated, 1 deleted\n", out)
+	})
+	t.Run("does not delete when the update fails", func(t *testing.T) {
+		f, c := factory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{})
+		c.EXPECT().WithContext(mock.Anything).Return(c)
+
+		c.On("UpdateTimeEntry", mock.Anything).
+			Return(dto.TimeEntryImpl{}, errors.New("update failed")).Once()
+
+		_, err := run(f)
+		assert.EqualError(t, err, "update failed")
+	})
+}
@@ -0,0 +1,168 @@
+package out
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+)
+
+// what to do with the idle time of a time entry
+const (
+	idleKeep    = "keep"
+	idleDiscard = "discard"
+	idleSplit   = "split"
+)
+
+var idleModes = cmdcompl.ValidArgsSlide{idleKeep, idleDiscard, idleSplit}
+
+// idleSince returns since when the time entry was idle, if it ran for longer
+// than the threshold or since before the last workday ended
+func idleSince(
+	start, end time.Time,
+	threshold, workdayEnd time.Duration,
+	workweek []string,
+) (time.Time, bool) {
+	start = start.In(end.Location())
+
+	var since time.Time
+	if workdayEnd > 0 {
+		d := time.Date(end.Year(), end.Month(), end.Day(),
+			0, 0, 0, 0, end.Location())
+		for i := 0; i < 7; i, d = i+1, d.AddDate(0, 0, -1) {
+			e := d.Add(workdayEnd)
+			if !e.Before(end) || (len(workweek) > 0 && !strhlp.InSlice(
+				strings.ToLower(d.Weekday().String()), workweek)) {
+				continue
+			}
+
+			if start.Before(e) {
+				since = e
+			}
+			break
+		}
+	}
+
+	if threshold > 0 && end.Sub(start) > threshold {
+		if t := start.Add(threshold); since.IsZero() || t.Before(since) {
+			since = t
+		}
+	}
+
+	return since, !since.IsZero()
+}
+
+// parseIdleSince converts the idle since informed by the user, times without a
+// date are on the day the time entry started (or on the next day, if before
+// it started)
+func parseIdleSince(s string, start time.Time) (time.Time, error) {
+	t, err := timehlp.ConvertToTime(s)
+	s = strings.TrimSpace(s)
+	if err != nil || len(s) > len(timehlp.OnlyTimeFormat) ||
+		!unicode.IsDigit(rune(s[0])) {
+		return t, err
+	}
+
+	start = start.In(t.Location())
+	t = time.Date(start.Year(), start.Month(), start.Day(),
+		t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	if t.Before(start) {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
+
+// askIdle asks what to do with the idle time, and since when the time entry
+// was idle
+func askIdle(
+	f cmdutil.Factory, te *dto.TimeEntry, end, since time.Time,
+) (string, time.Time, error) {
+	mode, err := f.UI().AskFromOptions(fmt.Sprintf(
+		"This time entry was running for %s, what to do with the idle time?",
+		dto.Duration{Duration: end.Sub(te.TimeInterval.Start)}.HumanString(),
+	), idleModes, idleKeep)
+	if err != nil || mode == idleKeep {
+		return mode, since, err
+	}
+
+	since, err = f.UI().AskForDateTime("Idle since",
+		since.Format(timehlp.FullTimeFormat),
+		func(s string) (time.Time, error) {
+			return parseIdleSince(s, te.TimeInterval.Start)
+		})
+	return mode, since, err
+}
+
+// stopIdle stops the time entry when it started to be idle, and creates a
+// time entry with the idle time when splitting it
+func stopIdle(
+	c api.Client, p api.OutParam, te *dto.TimeEntry, mode string,
+	since time.Time,
+) ([]dto.TimeEntry, error) {
+	end := p.End
+	if !since.After(te.TimeInterval.Start) || !since.Before(end) {
+		return nil, errors.New("idle since must be after " +
+			te.TimeInterval.Start.In(end.Location()).Format(
+				timehlp.FullTimeFormat) +
+			" and before " + end.Format(timehlp.FullTimeFormat))
+	}
+
+	p.End = since
+	if err := c.Out(p); err != nil {
+		return nil, err
+	}
+
+	stopped := *te
+	stopped.TimeInterval.End = &since
+	if mode != idleSplit {
+		return []dto.TimeEntry{stopped}, nil
+	}
+
+	tagIDs := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		tagIDs[i] = te.Tags[i].ID
+	}
+
+	taskID := ""
+	if te.Task != nil {
+		taskID = te.Task.ID
+	}
+
+	b := te.Billable
+	n, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:   p.Workspace,
+		Start:       since,
+		End:         &end,
+		Billable:    &b,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TaskID:      taskID,
+		TagIDs:      tagIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	idle := *te
+	idle.ID = n.ID
+	idle.TimeInterval = dto.NewTimeInterval(since, &end)
+
+	return []dto.TimeEntry{stopped, idle}, nil
+}
+
+func warnIdle(out io.Writer, since time.Time) error {
+	_, err := fmt.Fprintf(out, "the time entry seems to be idle since %s, "+
+		"use --idle to discard or split the idle time\n",
+		since.Format(timehlp.FullTimeFormat))
+	return err
+}
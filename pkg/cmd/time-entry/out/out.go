@@ -2,20 +2,25 @@ package out
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
 )
 
 // NewCmdOut represents the out command
 func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var mode string
 	cmd := &cobra.Command{
 		Use:   "out",
 		Short: "Stops the running time entry",
@@ -28,10 +33,19 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 			%[2]s
 
 			Use %[1]sclockify-cli edit current%[1]s to edit any properties before ending it.
+
+			A time entry running for longer than the config "%[4]s", or since before the end of the last workday (config "%[5]s"), is considered forgotten. Then it is asked (in interactive mode) what to do with its idle time:
+			- keep: stops the time entry at %[1]s--when%[1]s
+			- discard: stops the time entry when it started to be idle
+			- split: stops the time entry when it started to be idle, and creates another time entry for the idle time, to be edited later
+
+			Use %[1]s--idle%[1]s to choose one of them without being asked, and %[1]s--idle-since%[1]s to set when the idle time started (a time without a date is on the day the time entry started).
 			%[3]s
 		`, "`",
 			util.HelpDateTimeFormats,
 			util.HelpMoreInfoAboutPrinting,
+			cmdutil.CONF_IDLE_THRESHOLD,
+			cmdutil.CONF_WORKDAY_END,
 		),
 		Example: heredoc.Docf(`
 			# stop running time entry with current time
@@ -64,12 +78,22 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 
 			Tags:
 			 * Development (%[2]s62ae28b72518aa18da2acb49%[2]s)
+
+			# stop a time entry forgotten running overnight at 18:30 of the day
+			# it started
+			$ %[1]s out --idle discard --idle-since 18:30 -q
+			62af70d849445270d7c09fbd
 		`, "clockify-cli", "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := of.Check(); err != nil {
 				return err
 			}
 
+			if mode != "" && !strhlp.InSlice(mode, idleModes) {
+				return fmt.Errorf("idle \"%s\" is invalid, use one of: %s",
+					mode, strings.Join(idleModes, ", "))
+			}
+
 			var whenDate time.Time
 			var err error
 
@@ -107,17 +131,61 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if err = c.Out(api.OutParam{
+			p := api.OutParam{
 				Workspace: w,
 				UserID:    userID,
 				End:       whenDate,
-			}); err != nil {
+			}
+
+			cnf := f.Config()
+			since, idle := idleSince(te.TimeInterval.Start, whenDate,
+				cnf.IdleThreshold(), cnf.WorkdayEnd(), cnf.GetWorkWeekdays())
+			if s, _ := cmd.Flags().GetString("idle-since"); s != "" {
+				if since, err = parseIdleSince(
+					s, te.TimeInterval.Start); err != nil {
+					return err
+				}
+				idle = true
+			}
+
+			if !idle && (mode == idleDiscard || mode == idleSplit) {
+				return errors.New("no idle time was detected on the " +
+					"time entry, use --idle-since to set when it " +
+					"started to be idle")
+			}
+
+			if idle && mode == "" {
+				if cnf.IsInteractive() {
+					if mode, since, err = askIdle(
+						f, te, whenDate, since); err != nil {
+						return err
+					}
+				} else if err = warnIdle(cmd.ErrOrStderr(), since); err != nil {
+					return err
+				}
+			}
+
+			if idle && (mode == idleDiscard || mode == idleSplit) {
+				tes, err := stopIdle(c, p, te, mode, since)
+				if err != nil {
+					return err
+				}
+
+				if len(tes) == 1 {
+					return util.PrintTimeEntry(
+						&tes[0], cmd.OutOrStdout(), cnf, of)
+				}
+
+				return util.PrintTimeEntries(tes, cmd.OutOrStdout(), cnf, of)
+			}
+
+			if err = c.Out(p); err != nil {
 				return err
 			}
 
 			te.TimeInterval.End = &whenDate
 
-			return util.PrintTimeEntry(te, cmd.OutOrStdout(), f.Config(), of)
+			return util.PrintTimeEntry(te, cmd.OutOrStdout(), cnf, of)
 		},
 	}
 
@@ -126,6 +194,13 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 	cmd.Flags().String("when", time.Now().Format(timehlp.FullTimeFormat),
 		"when the entry should be closed, "+
 			"if not informed will use current time")
+	cmd.Flags().StringVar(&mode, "idle", "",
+		"what to do with the idle time of the time entry")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "idle", idleModes)
+	cmd.Flags().String("idle-since", "",
+		"when the time entry started to be idle, "+
+			"if not informed it will be detected (same formats as when, "+
+			"times without a date are on the day the time entry started)")
 
 	return cmd
 }
@@ -0,0 +1,199 @@
+package out_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/out"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type cnf struct {
+	threshold  time.Duration
+	workdayEnd time.Duration
+}
+
+func (c cnf) mock(t *testing.T) *mocks.MockConfig {
+	m := mocks.NewMockConfig(t)
+	m.EXPECT().IdleThreshold().Return(c.threshold)
+	m.EXPECT().WorkdayEnd().Return(c.workdayEnd)
+	m.EXPECT().GetWorkWeekdays().Return(nil)
+	m.EXPECT().IsInteractive().Return(false).Maybe()
+	m.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	m.EXPECT().SetBool(mock.Anything, mock.Anything).Maybe()
+	m.EXPECT().TimeZone().Return(time.Local).Maybe()
+	return m
+}
+
+func TestCmdOut_Idle(t *testing.T) {
+	today := timehlp.Today()
+	yesterday := today.AddDate(0, 0, -1)
+	when := today.Add(9 * time.Hour)
+	since := yesterday.Add(18 * time.Hour)
+
+	tts := []struct {
+		name   string
+		args   []string
+		cnf    cnf
+		end    time.Time
+		split  bool
+		out    string
+		errOut string
+		err    string
+		// invalid flags fail before any call
+		invalid bool
+	}{
+		{
+			name: "warns when not interactive",
+			cnf:  cnf{workdayEnd: 18 * time.Hour},
+			end:  when,
+			out:  "te1\n",
+			errOut: "the time entry seems to be idle since " +
+				since.Format(timehlp.FullTimeFormat) +
+				", use --idle to discard or split the idle time\n",
+		},
+		{
+			name: "not idle",
+			cnf:  cnf{threshold: 48 * time.Hour},
+			args: []string{"--idle", "discard"},
+			err:  "no idle time was detected",
+		},
+		{
+			name: "keep when not idle",
+			cnf:  cnf{threshold: 48 * time.Hour},
+			args: []string{"--idle", "keep"},
+			end:  when,
+			out:  "te1\n",
+		},
+		{
+			name: "discard after workday end",
+			cnf:  cnf{workdayEnd: 18 * time.Hour},
+			args: []string{"--idle", "discard"},
+			end:  since,
+			out:  "te1\n",
+		},
+		{
+			name: "discard after threshold",
+			cnf: cnf{
+				workdayEnd: 18 * time.Hour,
+				threshold:  4 * time.Hour,
+			},
+			args: []string{"--idle", "discard"},
+			end:  yesterday.Add(13 * time.Hour),
+			out:  "te1\n",
+		},
+		{
+			name: "split since",
+			cnf:  cnf{},
+			args: []string{"--idle", "split", "--idle-since",
+				when.Add(-time.Hour).Format(timehlp.FullTimeFormat)},
+			end:   when.Add(-time.Hour),
+			split: true,
+			out:   "te1\nte2\n",
+		},
+		{
+			name: "since without date is on the start day",
+			cnf:  cnf{},
+			args: []string{"--idle", "discard", "--idle-since", "18:00"},
+			end:  since,
+			out:  "te1\n",
+		},
+		{
+			name: "since before start",
+			cnf:  cnf{},
+			args: []string{"--idle", "split", "--idle-since",
+				yesterday.Format(timehlp.FullTimeFormat)},
+			err: "idle since must be after",
+		},
+		{
+			name:    "invalid mode",
+			cnf:     cnf{},
+			args:    []string{"--idle", "forget"},
+			err:     "idle \"forget\" is invalid, use one of: keep, discard, split",
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockClient(t)
+
+			if !tt.invalid {
+				f.EXPECT().Client().Return(c, nil)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().Config().Return(tt.cnf.mock(t))
+
+				c.EXPECT().GetHydratedTimeEntryInProgress(
+					api.GetTimeEntryInProgressParam{
+						Workspace: "w",
+						UserID:    "u",
+					}).
+					Return(&dto.TimeEntry{
+						ID:          "te1",
+						WorkspaceID: "w",
+						ProjectID:   "p1",
+						Description: "Coding",
+						Task:        &dto.Task{ID: "tk1"},
+						Tags:        []dto.Tag{{ID: "tg1"}},
+						TimeInterval: dto.NewTimeInterval(
+							yesterday.Add(9*time.Hour), nil),
+					}, nil)
+			}
+
+			if !tt.end.IsZero() {
+				c.EXPECT().Out(api.OutParam{
+					Workspace: "w",
+					UserID:    "u",
+					End:       tt.end,
+				}).Return(nil).Once()
+			}
+
+			if tt.split {
+				b := false
+				end := when
+				c.EXPECT().CreateTimeEntry(api.CreateTimeEntryParam{
+					Workspace:   "w",
+					Start:       tt.end,
+					End:         &end,
+					Billable:    &b,
+					Description: "Coding",
+					ProjectID:   "p1",
+					TaskID:      "tk1",
+					TagIDs:      []string{"tg1"},
+				}).Return(dto.TimeEntryImpl{ID: "te2"}, nil).Once()
+			}
+
+			cmd := out.NewCmdOut(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			o := &bytes.Buffer{}
+			e := &bytes.Buffer{}
+			cmd.SetOut(o)
+			cmd.SetErr(e)
+			cmd.SetArgs(append([]string{
+				"--when", when.Format(timehlp.FullTimeFormat), "-q"},
+				tt.args...))
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.err)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, o.String())
+			assert.Equal(t, tt.errOut, e.String())
+		})
+	}
+}
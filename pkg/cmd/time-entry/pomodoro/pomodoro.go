@@ -0,0 +1,327 @@
+package pomodoro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// Events informed to the notify command
+const (
+	EventFocusEnd = "focus-end"
+	EventBreakEnd = "break-end"
+)
+
+// NewCmdPomodoro represents the pomodoro command
+func NewCmdPomodoro(
+	f cmdutil.Factory,
+	report func(dto.TimeEntryImpl, io.Writer, util.OutputFlags) error,
+) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var (
+		p            pomodoro
+		breakProject string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "pomodoro [<project-id>] [<description>]",
+		Aliases: []string{"focus"},
+		Short:   "Tracks focus sessions, with breaks between them",
+		Long: heredoc.Docf(`
+			Starts a time entry (like the "in" command does) and stops it after --focus, showing a countdown on stderr in the meantime. Then waits for --break and starts the next cycle, until --cycles are done.
+
+			Breaks are tracked on the project set on the config "%[1]s" (or --break-project), if none is set they are not tracked.
+
+			When a focus or break ends the command set on the config "%[2]s" is run, with the environment variables CLOCKIFY_POMODORO_EVENT (%[3]s or %[4]s), CLOCKIFY_POMODORO_CYCLE and CLOCKIFY_POMODORO_MESSAGE set.
+
+			Interrupting the command (Ctrl+C) stops the running time entry.
+		`,
+			cmdutil.CONF_POMODORO_BREAK_PROJECT, cmdutil.CONF_POMODORO_NOTIFY,
+			EventFocusEnd, EventBreakEnd,
+		) + "\n" +
+			util.HelpInteractiveByDefault + "\n" +
+			util.HelpNamesForIds + "\n" +
+			util.HelpValidateIncomplete + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Args: cobra.MaximumNArgs(2),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f, f.Config())),
+		Example: heredoc.Docf(`
+			$ clockify-cli config set %[1]s 'notify-send Clockify "$CLOCKIFY_POMODORO_MESSAGE"'
+			$ clockify-cli config set %[2]s Breaks
+
+			# four cycles of 25 minutes of focus, with 5 minutes breaks
+			$ clockify-cli pomodoro -i=0 "Clockify CLI" "Writing docs" --cycles 4 -q
+			62ae4b304ebb4f143c931d50
+
+			# the countdown is shown on stderr
+			$ clockify-cli pomodoro -i=0 "Clockify CLI" "Writing docs" -q 2>&1 >/dev/null
+			focus 1/4: 24:59 left
+		`, cmdutil.CONF_POMODORO_NOTIFY, cmdutil.CONF_POMODORO_BREAK_PROJECT),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if p.Cycles < 1 {
+				return errors.New("cycles must be at least 1")
+			}
+
+			var err error
+			te := util.TimeEntryDTO{Start: timehlp.Now()}
+			if te.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if te.UserID, err = f.GetUserID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				te.ProjectID = args[0]
+			}
+
+			if len(args) > 1 {
+				te.Description = args[1]
+			}
+
+			cnf := f.Config()
+			if !cmd.Flags().Changed("break-project") {
+				breakProject = cnf.GetString(
+					cmdutil.CONF_POMODORO_BREAK_PROJECT)
+			}
+
+			p.c = c
+			p.ctx = cmd.Context()
+			p.out = cmd.OutOrStdout()
+			p.errOut = cmd.ErrOrStderr()
+			p.notify = cnf.GetString(cmdutil.CONF_POMODORO_NOTIFY)
+			p.report = func(te util.TimeEntryDTO) error {
+				return report(util.TimeEntryDTOToImpl(te), p.out, of)
+			}
+
+			if p.Break > 0 && breakProject != "" {
+				b, err := util.Do(util.TimeEntryDTO{
+					Workspace:   te.Workspace,
+					UserID:      te.UserID,
+					ProjectID:   breakProject,
+					Description: p.BreakDescription,
+				},
+					util.GetAllowNameForIDsFn(cnf, c),
+					util.FillMissingBillableFn(c),
+				)
+				if err != nil {
+					return err
+				}
+				p.breakEntry = &b
+			}
+
+			if te, err = util.Do(
+				te,
+				util.FillTimeEntryWithFlags(cmd.Flags()),
+				util.ValidateClosingTimeEntry(f),
+				util.GetAllowNameForIDsFn(cnf, c),
+				util.GetPropsInteractiveFn(util.NewDescriptionCompleter(f), f),
+				util.FillMissingBillableFn(c),
+				util.GetValidateTimeEntryFn(f),
+			); err != nil {
+				return err
+			}
+
+			return p.run(te)
+		},
+	}
+
+	util.AddTimeEntryFlags(cmd, f, &of)
+	cmd.Flags().DurationVar(&p.Focus, "focus", 25*time.Minute,
+		"how long each focus lasts")
+	cmd.Flags().DurationVar(&p.Break, "break", 5*time.Minute,
+		"how long each break lasts (0 disables them)")
+	cmd.Flags().IntVarP(&p.Cycles, "cycles", "N", 1,
+		"how many focus sessions to track")
+	cmd.Flags().StringVar(&breakProject, "break-project", "",
+		"project used to track the breaks (defaults to the config \""+
+			cmdutil.CONF_POMODORO_BREAK_PROJECT+"\")")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "break-project",
+		cmdcomplutil.NewProjectAutoComplete(f, f.Config()))
+	cmd.Flags().StringVar(&p.BreakDescription, "break-description", "Break",
+		"description of the breaks time entries")
+
+	return cmd
+}
+
+type pomodoro struct {
+	Focus            time.Duration
+	Break            time.Duration
+	Cycles           int
+	BreakDescription string
+
+	ctx        context.Context
+	c          api.Client
+	out        io.Writer
+	errOut     io.Writer
+	notify     string
+	report     func(util.TimeEntryDTO) error
+	breakEntry *util.TimeEntryDTO
+}
+
+func (p *pomodoro) run(te util.TimeEntryDTO) error {
+	for i := 1; i <= p.Cycles; i++ {
+		cycle := fmt.Sprintf("%d/%d", i, p.Cycles)
+
+		te.ID = ""
+		te.End = nil
+		if err := p.track(te, "focus "+cycle, p.Focus); err != nil {
+			return err
+		}
+
+		if i == p.Cycles {
+			p.runNotify(EventFocusEnd, cycle,
+				"All "+strconv.Itoa(p.Cycles)+" focus sessions are done")
+			break
+		}
+
+		if p.Break <= 0 {
+			p.runNotify(EventFocusEnd, cycle, "Focus "+cycle+" is done")
+			continue
+		}
+
+		p.runNotify(EventFocusEnd, cycle, "Focus "+cycle+
+			" is done, take a break of "+
+			dto.Duration{Duration: p.Break}.HumanString())
+
+		if p.breakEntry == nil {
+			if err := p.countdown("break "+cycle, p.Break); err != nil {
+				return err
+			}
+		} else if err := p.track(
+			*p.breakEntry, "break "+cycle, p.Break); err != nil {
+			return err
+		}
+
+		p.runNotify(EventBreakEnd, cycle, "Break "+cycle+" is over")
+	}
+
+	return nil
+}
+
+// track starts the time entry, waits for d and then stops it. If
+// interrupted, the time entry is stopped at the current time
+func (p *pomodoro) track(
+	te util.TimeEntryDTO, name string, d time.Duration,
+) (err error) {
+	out := api.OutParam{Workspace: te.Workspace, UserID: te.UserID}
+	te.Start = timehlp.Now()
+	if te, err = util.Do(
+		te,
+		util.OutInProgressFn(p.c),
+		util.CreateTimeEntryFn(p.c),
+	); err != nil {
+		return err
+	}
+
+	if err := p.report(te); err != nil {
+		return err
+	}
+
+	out.End = te.Start.Add(d)
+	if err := p.countdown(name, d); err != nil {
+		// the client is cancelled with the command
+		out.End = timehlp.Now()
+		if oerr := p.c.WithContext(context.Background()).Out(out); oerr != nil {
+			return oerr
+		}
+
+		return err
+	}
+
+	return p.c.Out(out)
+}
+
+// countdown shows how much time is left until d passes, returning early if
+// the command is interrupted
+func (p *pomodoro) countdown(name string, d time.Duration) error {
+	done := time.NewTimer(d)
+	defer done.Stop()
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+
+	end := time.Now().Add(d)
+	for {
+		_, _ = fmt.Fprintf(p.errOut, "\r%s: %s left ", name,
+			durationString(time.Until(end)))
+
+		select {
+		case <-p.ctx.Done():
+			_, _ = fmt.Fprintln(p.errOut)
+			return p.ctx.Err()
+		case <-done.C:
+			_, _ = fmt.Fprintf(p.errOut, "\r%s: done      \n", name)
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// runNotify runs the notify command set on the config, failures are only
+// reported, as they should not stop the pomodoro
+func (p *pomodoro) runNotify(event, cycle, message string) {
+	if p.notify == "" {
+		return
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	cmd := exec.CommandContext(p.ctx, shell, flag, p.notify)
+	cmd.Env = append(os.Environ(),
+		"CLOCKIFY_POMODORO_EVENT="+event,
+		"CLOCKIFY_POMODORO_CYCLE="+cycle,
+		"CLOCKIFY_POMODORO_MESSAGE="+message,
+	)
+	cmd.Stdout = p.errOut
+	cmd.Stderr = p.errOut
+
+	if err := cmd.Run(); err != nil {
+		_, _ = fmt.Fprintf(p.errOut, "notify command failed: %s\n", err)
+	}
+}
+
+// durationString formats a duration like a clock, as 24:59
+func durationString(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	d = d.Round(time.Second)
+	s := int(d.Seconds())
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s%3600/60, s%60)
+	}
+
+	return fmt.Sprintf("%02d:%02d", s/60, s%60)
+}
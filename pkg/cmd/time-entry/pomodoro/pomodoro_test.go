@@ -0,0 +1,144 @@
+package pomodoro_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/pomodoro"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newFactory(t *testing.T, cnf *mocks.SimpleConfig) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Config().Return(cnf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+		Workspace: "w",
+		UserID:    "u",
+	}).Return(nil, nil)
+
+	return f, c
+}
+
+func isProject(p string) interface{} {
+	return mock.MatchedBy(func(cp api.CreateTimeEntryParam) bool {
+		return cp.ProjectID == p
+	})
+}
+
+func runCmd(
+	ctx context.Context, f *mocks.MockFactory, args ...string,
+) (string, string, []string, error) {
+	var reported []string
+	cmd := pomodoro.NewCmdPomodoro(f, func(
+		te dto.TimeEntryImpl, _ io.Writer, _ util.OutputFlags) error {
+		reported = append(reported, te.ID)
+		return nil
+	})
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs(args)
+
+	_, err := cmd.ExecuteContextC(ctx)
+	return out.String(), errOut.String(), reported, err
+}
+
+func TestCmdPomodoro(t *testing.T) {
+	f, c := newFactory(t, &mocks.SimpleConfig{
+		AllowIncomplete:      true,
+		PomodoroBreakProject: "breaks",
+		PomodoroNotify: "echo " +
+			"\"$CLOCKIFY_POMODORO_EVENT $CLOCKIFY_POMODORO_CYCLE: " +
+			"$CLOCKIFY_POMODORO_MESSAGE\"",
+	})
+
+	c.EXPECT().GetProject(api.GetProjectParam{
+		Workspace: "w",
+		ProjectID: "breaks",
+	}).Return(&dto.Project{ID: "breaks"}, nil)
+
+	c.EXPECT().CreateTimeEntry(isProject("p1")).
+		Return(dto.TimeEntryImpl{ID: "focus"}, nil).Twice()
+	c.EXPECT().CreateTimeEntry(isProject("breaks")).
+		Return(dto.TimeEntryImpl{ID: "break"}, nil).Once()
+
+	stops := 0
+	c.EXPECT().Out(mock.Anything).Run(func(p api.OutParam) {
+		assert.Equal(t, "w", p.Workspace)
+		assert.Equal(t, "u", p.UserID)
+		stops++
+	}).Return(nil)
+
+	out, errOut, reported, err := runCmd(context.Background(), f,
+		"-p", "p1", "-b", "--focus", "20ms", "--break", "10ms", "-N", "2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"focus", "break", "focus"}, reported)
+	// each time entry stops the previous one before starting and is stopped
+	// at its end
+	assert.Equal(t, 6, stops)
+
+	// the countdown is not mixed with the output of the time entries
+	assert.Empty(t, out)
+	assert.Contains(t, errOut, "focus 1/2: done")
+	assert.Contains(t, errOut, "break 1/2: done")
+	assert.Contains(t, errOut, "focus 2/2: done")
+
+	var notified []string
+	for _, l := range strings.Split(errOut, "\n") {
+		if strings.Contains(l, "-end ") {
+			notified = append(notified, l)
+		}
+	}
+	assert.Equal(t, []string{
+		"focus-end 1/2: Focus 1/2 is done, take a break of 0:00:00",
+		"break-end 1/2: Break 1/2 is over",
+		"focus-end 2/2: All 2 focus sessions are done",
+	}, notified)
+}
+
+func TestCmdPomodoro_StopsWhenInterrupted(t *testing.T) {
+	f, c := newFactory(t, &mocks.SimpleConfig{AllowIncomplete: true})
+
+	c.EXPECT().CreateTimeEntry(isProject("p1")).
+		Return(dto.TimeEntryImpl{ID: "focus"}, nil).Once()
+	c.EXPECT().WithContext(mock.Anything).Return(c)
+
+	var ends []time.Time
+	c.EXPECT().Out(mock.Anything).Run(func(p api.OutParam) {
+		ends = append(ends, p.End)
+	}).Return(nil)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, _, err := runCmd(ctx, f, "-p", "p1", "-b", "--focus", "1h")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	if assert.Len(t, ends, 2) {
+		assert.WithinDuration(t, start, ends[1], time.Second)
+	}
+}
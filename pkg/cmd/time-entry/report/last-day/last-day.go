@@ -39,6 +39,7 @@ func NewCmdLastDay(f cmdutil.Factory) *cobra.Command {
 			}
 
 			return util.ReportWithRange(
+				cmd.Context(),
 				f, te.TimeInterval.Start, te.TimeInterval.Start,
 				cmd.OutOrStdout(), of)
 		},
@@ -29,6 +29,8 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 			Or more directly by running the set command as follows:
 			$ clockify-cli config set workweek-days monday,tuesday,wednesday,thursday,friday
 
+			Holidays and days off (see "time-off") are skipped too.
+
 			%s
 			%s
 		`,
@@ -41,33 +43,12 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			workweek := f.Config().GetWorkWeekdays()
-			if len(workweek) == 0 {
-				return errors.New("no workweek days were set")
-			}
-
-			day := timehlp.Today().Add(-1)
-			if strhlp.Search(
-				strings.ToLower(day.Weekday().String()), workweek) != -1 {
-				return util.ReportWithRange(f, day, day, cmd.OutOrStdout(), of)
-			}
-
-			dayWeekday := int(day.Weekday())
-			if dayWeekday == int(time.Sunday) {
-				dayWeekday = int(time.Saturday + 1)
-			}
-
-			lastWeekDay := int(time.Sunday)
-			for _, w := range workweek {
-				i := strhlp.Search(w, cmdutil.GetWeekdays())
-				if i > lastWeekDay && i < dayWeekday {
-					lastWeekDay = i
-				}
+			day, err := lastWorkingDay(f, timehlp.Today())
+			if err != nil {
+				return err
 			}
 
-			day = day.Add(
-				time.Duration(-24*(dayWeekday-lastWeekDay)) * time.Hour)
-			return util.ReportWithRange(f, day, day, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, day, day, cmd.OutOrStdout(), of)
 		},
 	}
 
@@ -75,3 +56,33 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 
 	return cmd
 }
+
+// lastWorkingDay returns the last day before today that is on the workweek,
+// and is not a holiday or day off
+func lastWorkingDay(f cmdutil.Factory, today time.Time) (time.Time, error) {
+	workweek := f.Config().GetWorkWeekdays()
+	if len(workweek) == 0 {
+		return today, errors.New("no workweek days were set")
+	}
+
+	cal, err := f.TimeOff()
+	if err != nil {
+		return today, err
+	}
+
+	day := today
+	for i := 0; i < 366; i++ {
+		day = day.AddDate(0, 0, -1)
+		if !strhlp.InSlice(
+			strings.ToLower(day.Weekday().String()), workweek) {
+			continue
+		}
+
+		off, err := cal.DayOff(day)
+		if err != nil || !off {
+			return day, err
+		}
+	}
+
+	return today, errors.New("no working day was found on the last year")
+}
@@ -0,0 +1,88 @@
+package lastweekday_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	lastweekday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week-day"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdLastWeekDay(t *testing.T) {
+	t.Run("no workweek", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{})
+
+		cmd := lastweekday.NewCmdLastWeekDay(f)
+		cmd.SetArgs([]string{})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(&bytes.Buffer{})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "no workweek days were set")
+	})
+
+	t.Run("skips days off", func(t *testing.T) {
+		today := timehlp.Today()
+		day := today.AddDate(0, 0, -4)
+
+		// every day since a week ago is a day off, but the expected one
+		// (half days are still working days)
+		content := ""
+		for d := today.AddDate(0, 0, -7); d.Before(today); d = d.AddDate(0, 0, 1) {
+			content += "- date: " + d.Format(worktime.DateFormat) + "\n"
+			if d.Equal(day) {
+				content += "  half: true\n"
+			}
+		}
+
+		p := filepath.Join(t.TempDir(), "time-off.yaml")
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(&mocks.SimpleConfig{
+			WorkweekDays: cmdutil.GetWeekdays(),
+		})
+		f.On("TimeOff").Return(worktime.NewCalendar(p), nil)
+		f.On("GetWorkspaceID").Return("w", nil)
+		f.On("GetUserID").Return("u", nil)
+
+		c := mocks.NewMockClient(t)
+		c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
+		f.On("Client").Return(c, nil)
+
+		first := timehlp.TruncateDate(day)
+		c.On("LogRange", api.LogRangeParam{
+			Workspace:       "w",
+			UserID:          "u",
+			FirstDate:       first,
+			LastDate:        first.Add(24 * time.Hour),
+			TagIDs:          []string{},
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.TimeEntry{{ID: "te1"}}, nil).Once()
+
+		out := &bytes.Buffer{}
+		cmd := lastweekday.NewCmdLastWeekDay(f)
+		cmd.SetArgs([]string{"-q"})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(out)
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.Equal(t, "te1\n", out.String())
+	})
+}
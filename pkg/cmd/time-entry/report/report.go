@@ -1,13 +1,12 @@
 package report
 
 import (
-	"time"
-
 	"github.com/MakeNowJust/heredoc"
 	lastday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-day"
 	lastmonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-month"
 	lastweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week"
 	lastweekday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week-day"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/summary"
 	thismonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-month"
 	thisweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-week"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/today"
@@ -138,29 +137,13 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			var err error
-
-			start := timehlp.Today()
-			if len(args) > 0 {
-				start, err = time.Parse("2006-01-02", args[0])
-				if err != nil {
-					return err
-				}
-			}
-
-			end := start
-			if len(args) > 1 {
-				if args[1] == "now" || args[1] == "today" {
-					end = timehlp.Today()
-				} else if args[1] == "yesterday" {
-					end = timehlp.Today().Add(-1)
-				} else if end, err = time.Parse(
-					"2006-01-02", args[1]); err != nil {
-					return err
-				}
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
 			}
 
 			return util.ReportWithRange(
+				cmd.Context(),
 				f, start, end, cmd.OutOrStdout(), of)
 		},
 	}
@@ -173,6 +156,7 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(lastweekday.NewCmdLastWeekDay(f))
 	cmd.AddCommand(today.NewCmdToday(f))
 	cmd.AddCommand(yesterday.NewCmdYesterday(f))
+	cmd.AddCommand(summary.NewCmdSummary(f))
 
 	util.AddReportFlags(f, cmd, &of)
 	_ = cmd.MarkFlagRequired("workspace")
@@ -0,0 +1,67 @@
+package summary
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSummary represents the report summary command
+func NewCmdSummary(f cmdutil.Factory) *cobra.Command {
+	of := util.NewReportFlags()
+	cmd := &cobra.Command{
+		Use:   "summary [<start>] [<end>]",
+		Short: "Sums the time entries of a date range by project, task, etc",
+		Long: heredoc.Docf(`
+			Sums the duration, billable duration and billable amount of the time entries for a given date range, grouping them by the dimensions set with --group-by (by project if not set).
+
+			Each group shows the subtotal of its time entries, and is followed by its sub groups of the next dimension. Time entries with more than one tag are summed on each one of their tags.
+
+			The arguments work the same way as the "report" command, and the flag --group-by can also be used with the other report commands.
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# sum the time entries of today by project
+			$ clockify-cli report summary
+			+--------------+----------+----------+-----------+
+			|   Project    | Duration | Billable |  Amount   |
+			+--------------+----------+----------+-----------+
+			| Clockify Cli |  2:00:00 |  2:00:00 | USD 40.00 |
+			| Special      |  1:00:00 |  0:00:00 |           |
+			+--------------+----------+----------+-----------+
+			|        Total |  3:00:00 |  2:00:00 | USD 40.00 |
+			+--------------+----------+----------+-----------+
+
+			# sum the time entries of june by project and task, as CSV
+			$ clockify-cli report summary 2022-06-01 2022-06-30 --group-by project,task --csv
+
+			# sum the time entries of this month by week
+			$ clockify-cli report this-month --group-by week
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(of.GroupBy) == 0 {
+				of.GroupBy = []string{"project"}
+			}
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
+			}
+
+			return util.ReportWithRange(
+				cmd.Context(),
+				f, start, end, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(f, cmd, &of)
+
+	return cmd
+}
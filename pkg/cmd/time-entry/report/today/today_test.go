@@ -14,6 +14,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/today"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdToday(t *testing.T) {
@@ -65,6 +66,7 @@ func TestCmdToday(t *testing.T) {
 				f.On("Config").Return(cf)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 				c.On("LogRange", api.LogRangeParam{
 					Workspace:       "w-id",
@@ -93,6 +95,7 @@ func TestCmdToday(t *testing.T) {
 				f.EXPECT().Config().Return(&mocks.SimpleConfig{})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 				c.On("LogRange", api.LogRangeParam{
 					Workspace:       "w-id",
@@ -124,6 +127,7 @@ func TestCmdToday(t *testing.T) {
 				})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -167,6 +171,7 @@ func TestCmdToday(t *testing.T) {
 				f.On("Config").Return(&mocks.SimpleConfig{})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -204,6 +209,7 @@ func TestCmdToday(t *testing.T) {
 				f.On("Config").Return(&mocks.SimpleConfig{})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
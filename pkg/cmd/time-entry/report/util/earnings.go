@@ -0,0 +1,40 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// printWithEarnings prints the time entries with the amounts earned and
+// spent on them, using the rates of the workspace, projects and tasks
+func printWithEarnings(
+	f cmdutil.Factory, tes []dto.TimeEntry, out io.Writer, rf ReportFlags,
+) error {
+	userID, err := f.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	workspace, err := f.GetWorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	r, err := ratehlp.Load(c, workspace, userID)
+	if err != nil {
+		return err
+	}
+
+	return util.PrintTimeEntriesWithEarnings(
+		tes, r.Earnings(tes, timehlp.Now()), out, f.Config(), rf.OutputFlags)
+}
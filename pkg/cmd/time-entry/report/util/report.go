@@ -1,9 +1,11 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"io"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
@@ -12,8 +14,10 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/summary"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 )
@@ -38,6 +42,13 @@ type ReportFlags struct {
 	Client      string
 	Projects    []string
 	TagIDs      []string
+
+	Timesheet       bool
+	TimesheetByTask bool
+
+	GroupBy []string
+
+	WithEarnings bool
 }
 
 // Check will assure that there is no conflicting flag values
@@ -58,6 +69,61 @@ func (rf ReportFlags) Check() error {
 		return err
 	}
 
+	if rf.TimesheetByTask && !rf.Timesheet {
+		return cmdutil.FlagErrorWrap(
+			errors.New("timesheet-by-task can't be used without timesheet"))
+	}
+
+	if rf.Timesheet {
+		if err := cmdutil.XorFlag(map[string]bool{
+			"timesheet":          true,
+			"limit":              rf.Limit > 0,
+			"fill-missing-dates": rf.FillMissingDates,
+			"format":             rf.Format != "",
+			"ics":                rf.ICS,
+			"quiet":              rf.Quiet,
+			"duration-float":     rf.DurationFloat,
+			"duration-formatted": rf.DurationFormatted,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(rf.GroupBy) > 0 {
+		if err := summary.CheckDimensions(rf.GroupBy); err != nil {
+			return cmdutil.FlagErrorWrap(err)
+		}
+
+		if err := cmdutil.XorFlag(map[string]bool{
+			"group-by":           true,
+			"timesheet":          rf.Timesheet,
+			"fill-missing-dates": rf.FillMissingDates,
+			"format":             rf.Format != "",
+			"ics":                rf.ICS,
+			"quiet":              rf.Quiet,
+			"md":                 rf.Markdown,
+			"duration-float":     rf.DurationFloat,
+			"duration-formatted": rf.DurationFormatted,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if rf.WithEarnings {
+		if err := cmdutil.XorFlag(map[string]bool{
+			"with-earnings":      true,
+			"timesheet":          rf.Timesheet,
+			"group-by":           len(rf.GroupBy) > 0,
+			"format":             rf.Format != "",
+			"ics":                rf.ICS,
+			"quiet":              rf.Quiet,
+			"duration-float":     rf.DurationFloat,
+			"duration-formatted": rf.DurationFormatted,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return cmdutil.XorFlag(map[string]bool{
 		"billable":     rf.Billable,
 		"not-billable": rf.NotBillable,
@@ -106,26 +172,127 @@ func AddReportFlags(
 		"Will filter time entries that are billable")
 	cmd.Flags().BoolVar(&rf.NotBillable, "not-billable", false,
 		"Will filter time entries that are not billable")
+
+	cmd.Flags().BoolVar(&rf.Timesheet, "timesheet", false,
+		"shows the time spent by project on each day as a grid "+
+			"(can be used with --csv, --json and --md)")
+	cmd.Flags().BoolVar(&rf.TimesheetByTask, "timesheet-by-task", false,
+		"break the timesheet rows by task")
+
+	cmd.Flags().StringSliceVar(&rf.GroupBy, "group-by", []string{},
+		"sums the time entries by these dimensions, in order, showing "+
+			"subtotals (can be used with --csv and --json). Dimensions: "+
+			strings.Join(summary.Dimensions, ", "))
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "group-by",
+		cmdcompl.ValidArgsSlide(summary.Dimensions))
+
+	cmd.Flags().BoolVar(&rf.WithEarnings, "with-earnings", false,
+		"shows the amount earned, the cost and the profit of each time "+
+			"entry, and their totals by currency")
 }
 
-// ReportWithRange fetches and prints out time entries
+// ParseRangeArgs reads the optional <start> and <end> arguments of the report
+// commands, start defaults to today and end to start
+func ParseRangeArgs(args []string) (start, end time.Time, err error) {
+	start = timehlp.Today()
+	if len(args) > 0 {
+		start, err = time.Parse("2006-01-02", args[0])
+		if err != nil {
+			return start, end, err
+		}
+	}
+
+	end = start
+	if len(args) > 1 {
+		if args[1] == "now" || args[1] == "today" {
+			end = timehlp.Today()
+		} else if args[1] == "yesterday" {
+			end = timehlp.Today().Add(-1)
+		} else if end, err = time.Parse(
+			"2006-01-02", args[1]); err != nil {
+			return start, end, err
+		}
+	}
+
+	return start, end, nil
+}
+
+// ReportWithRange fetches and prints out time entries, the requests are
+// cancelled when ctx is done
 func ReportWithRange(
-	f cmdutil.Factory, start, end time.Time,
+	ctx context.Context, f cmdutil.Factory, start, end time.Time,
 	out io.Writer, rf ReportFlags,
 ) error {
-	userId, err := f.GetUserID()
+	log, err := GetTimeEntriesWithRange(ctx, f, start, end, rf)
 	if err != nil {
 		return err
 	}
 
+	if rf.Timesheet {
+		return printTimesheet(f.Config(), log, start, end, out, rf)
+	}
+
+	if len(rf.GroupBy) > 0 {
+		return printSummary(f.Config(), log, out, rf)
+	}
+
+	if rf.FillMissingDates && len(log) > 0 {
+		start = timehlp.TruncateDate(start)
+		end = timehlp.TruncateDate(end).Add(time.Hour * 24)
+
+		l := log
+		log = make([]dto.TimeEntry, 0, len(l))
+		log = append(log, fillMissing(start, l[0].TimeInterval.Start)...)
+
+		nextDay := start
+		for i := range l {
+			log = append(log,
+				fillMissing(nextDay, l[i].TimeInterval.Start)...)
+			log = append(log, l[i])
+			nextDay = l[i].TimeInterval.Start.Add(
+				time.Duration(24-l[i].TimeInterval.Start.Hour()) * time.Hour)
+		}
+
+		log = append(log, fillMissing(nextDay, end)...)
+
+		cal, err := f.TimeOff()
+		if err != nil {
+			return err
+		}
+
+		if log, err = skipDaysOff(log, cal); err != nil {
+			return err
+		}
+	}
+
+	if rf.WithEarnings {
+		return printWithEarnings(f, log, out, rf)
+	}
+
+	return util.PrintTimeEntries(
+		log, out, f.Config(), rf.OutputFlags)
+}
+
+// GetTimeEntriesWithRange fetches the time entries of the user between the
+// dates (inclusive) applying the filters of the ReportFlags, sorted by their
+// start
+func GetTimeEntriesWithRange(
+	ctx context.Context, f cmdutil.Factory, start, end time.Time,
+	rf ReportFlags,
+) ([]dto.TimeEntry, error) {
+	userId, err := f.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
 	workspace, err := f.GetWorkspaceID()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	c, err := f.Client()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	cnf := f.Config()
@@ -133,14 +300,14 @@ func ReportWithRange(
 		if f.Config().IsAllowNameForID() {
 			if rf.Projects, err = search.GetProjectsByName(
 				c, cnf, workspace, rf.Client, rf.Projects); err != nil {
-				return err
+				return nil, err
 			}
 		}
 	} else if rf.Client != "" {
 		if f.Config().IsAllowNameForID() {
 			if rf.Client, err = search.GetClientByName(
 				c, workspace, rf.Client); err != nil {
-				return err
+				return nil, err
 			}
 		}
 
@@ -151,7 +318,7 @@ func ReportWithRange(
 			PaginationParam: api.AllPages(),
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		rf.Projects = make([]string, len(ps))
@@ -163,7 +330,7 @@ func ReportWithRange(
 	if len(rf.TagIDs) > 0 && f.Config().IsAllowNameForID() {
 		if rf.TagIDs, err = search.GetTagsByName(
 			c, workspace, rf.TagIDs); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -174,7 +341,8 @@ func ReportWithRange(
 	start = timehlp.TruncateDate(start)
 	end = timehlp.TruncateDate(end).Add(time.Hour * 24)
 
-	wg := errgroup.Group{}
+	wg, ctx := errgroup.WithContext(ctx)
+	gc := c.WithContext(ctx)
 	logs := make([][]dto.TimeEntry, len(rf.Projects))
 
 	pages := api.AllPages()
@@ -193,7 +361,7 @@ func ReportWithRange(
 		i := i
 		wg.Go(func() error {
 			var err error
-			logs[i], err = c.LogRange(api.LogRangeParam{
+			logs[i], err = gc.LogRange(api.LogRangeParam{
 				Workspace:       workspace,
 				UserID:          userId,
 				FirstDate:       start,
@@ -209,7 +377,7 @@ func ReportWithRange(
 	}
 
 	if err = wg.Wait(); err != nil {
-		return err
+		return nil, err
 	}
 
 	log := make([]dto.TimeEntry, 0)
@@ -231,25 +399,7 @@ func ReportWithRange(
 		log = log[len(log)-rf.Limit:]
 	}
 
-	if rf.FillMissingDates && len(log) > 0 {
-		l := log
-		log = make([]dto.TimeEntry, 0, len(l))
-		log = append(log, fillMissing(start, l[0].TimeInterval.Start)...)
-
-		nextDay := start
-		for i := range l {
-			log = append(log,
-				fillMissing(nextDay, l[i].TimeInterval.Start)...)
-			log = append(log, l[i])
-			nextDay = l[i].TimeInterval.Start.Add(
-				time.Duration(24-l[i].TimeInterval.Start.Hour()) * time.Hour)
-		}
-
-		log = append(log, fillMissing(nextDay, end)...)
-	}
-
-	return util.PrintTimeEntries(
-		log, out, cnf, rf.OutputFlags)
+	return log, nil
 }
 
 func filterBilling(l []dto.TimeEntry, billable bool) []dto.TimeEntry {
@@ -263,6 +413,30 @@ func filterBilling(l []dto.TimeEntry, billable bool) []dto.TimeEntry {
 	return r
 }
 
+// skipDaysOff removes the empty lines added for the holidays and days off, as
+// they are not missing
+func skipDaysOff(
+	log []dto.TimeEntry, cal *worktime.Calendar,
+) ([]dto.TimeEntry, error) {
+	r := make([]dto.TimeEntry, 0, len(log))
+	for i := range log {
+		if log[i].ID == "" {
+			off, err := cal.DayOff(log[i].TimeInterval.Start)
+			if err != nil {
+				return nil, err
+			}
+
+			if off {
+				continue
+			}
+		}
+
+		r = append(r, log[i])
+	}
+
+	return r, nil
+}
+
 func fillMissing(first, last time.Time) []dto.TimeEntry {
 	first = timehlp.TruncateDate(first)
 	last = timehlp.TruncateDate(last)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -76,6 +77,58 @@ func TestReportFlags_Check(t *testing.T) {
 			},
 			err: "page can't be used without limit",
 		},
+		"group by": {
+			rf: util.ReportFlags{
+				GroupBy:     []string{"project", "task"},
+				OutputFlags: timeentry.OutputFlags{CSV: true},
+			},
+		},
+		"group by invalid dimension": {
+			rf: util.ReportFlags{
+				GroupBy: []string{"project", "user"},
+			},
+			err: `dimension "user" is not valid`,
+		},
+		"group by and timesheet": {
+			rf: util.ReportFlags{
+				GroupBy:   []string{"project"},
+				Timesheet: true,
+			},
+			err: "can't be used together.*group-by.*timesheet",
+		},
+		"group by and format": {
+			rf: util.ReportFlags{
+				GroupBy:     []string{"project"},
+				OutputFlags: timeentry.OutputFlags{Format: "{{.ID}}"},
+			},
+			err: "can't be used together.*format.*group-by",
+		},
+		"with earnings": {
+			rf: util.ReportFlags{
+				WithEarnings: true,
+				OutputFlags:  timeentry.OutputFlags{Markdown: true},
+			},
+		},
+		"with earnings and quiet": {
+			rf: util.ReportFlags{
+				WithEarnings: true,
+				OutputFlags:  timeentry.OutputFlags{Quiet: true},
+			},
+			err: "can't be used together.*quiet.*with-earnings",
+		},
+		"only one output": {
+			rf: util.ReportFlags{
+				OutputFlags: timeentry.OutputFlags{ICS: true, CSV: true},
+			},
+			err: "can't be used together.*csv.*ics",
+		},
+		"timesheet and ics": {
+			rf: util.ReportFlags{
+				Timesheet:   true,
+				OutputFlags: timeentry.OutputFlags{ICS: true},
+			},
+			err: "can't be used together.*ics.*timesheet",
+		},
 	}
 
 	for name, tt := range tts {
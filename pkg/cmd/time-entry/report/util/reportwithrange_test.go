@@ -2,7 +2,10 @@ package util_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -13,7 +16,9 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func newDate(s string) time.Time {
@@ -21,6 +26,15 @@ func newDate(s string) time.Time {
 	return date
 }
 
+func newCalendar(t *testing.T, content string) *worktime.Calendar {
+	p := filepath.Join(t.TempDir(), "time-off.yaml")
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return worktime.NewCalendar(p)
+}
+
 func TestReportWithRange(t *testing.T) {
 	date := newDate("2006-01-02")
 	first := time.Date(
@@ -92,6 +106,7 @@ func TestReportWithRange(t *testing.T) {
 				cf.On("IsAllowNameForID").Return(true)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -121,6 +136,7 @@ func TestReportWithRange(t *testing.T) {
 				cf.On("IsSearchProjectWithClientsName").Return(false)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -149,6 +165,7 @@ func TestReportWithRange(t *testing.T) {
 				cf.On("IsAllowNameForID").Return(true)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -179,6 +196,7 @@ func TestReportWithRange(t *testing.T) {
 				cf.On("IsSearchProjectWithClientsName").Return(false)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -216,6 +234,7 @@ func TestReportWithRange(t *testing.T) {
 				cf.On("IsSearchProjectWithClientsName").Return(false)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("GetProjects", api.GetProjectsParam{
@@ -269,6 +288,7 @@ func TestReportWithRange(t *testing.T) {
 				})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -308,8 +328,10 @@ func TestReportWithRange(t *testing.T) {
 				f.On("GetWorkspaceID").Return("w", nil)
 
 				f.On("Config").Return(&mocks.SimpleConfig{})
+				f.On("TimeOff").Return(newCalendar(t, ""), nil)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -342,6 +364,54 @@ func TestReportWithRange(t *testing.T) {
 				time-entry-1;2006-01-04
 			`),
 		},
+		{
+			name: "fill missing dates skips days off",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				f.On("Config").Return(&mocks.SimpleConfig{})
+				f.On("TimeOff").Return(newCalendar(t, heredoc.Doc(`
+					- date: 2006-01-02
+					  half: true
+					- date: 2006-01-03
+					  name: Holiday
+				`)), nil)
+
+				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "time-entry-1", TimeInterval: dto.TimeInterval{
+						Start: newDate("2006-01-04")}},
+					{ID: "time-entry-2", TimeInterval: dto.TimeInterval{
+						Start: newDate("2006-01-01")}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.FillMissingDates = true
+				rf.Format = "{{.ID}};{{ .TimeInterval.Start.Format " +
+					`"2006-01-02"` +
+					" }}"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				time-entry-2;2006-01-01
+				;2006-01-02
+				time-entry-1;2006-01-04
+			`),
+		},
 		{
 			name: "billable only",
 			factory: func(t *testing.T) cmdutil.Factory {
@@ -352,6 +422,7 @@ func TestReportWithRange(t *testing.T) {
 				f.EXPECT().Config().Return(&mocks.SimpleConfig{})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -379,6 +450,69 @@ func TestReportWithRange(t *testing.T) {
 				time-entry-3
 			`),
 		},
+		{
+			name: "with earnings",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(2 * time.Hour)
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:        "te-1",
+						Billable:  true,
+						ProjectID: "p1",
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+				}, nil)
+
+				c.On("GetWorkspace", api.GetWorkspace{ID: "w"}).
+					Return(dto.Workspace{
+						ID:         "w",
+						HourlyRate: dto.Rate{Amount: 1000, Currency: "USD"},
+					}, nil)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					Hydrate:         true,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{
+					ID:         "p1",
+					HourlyRate: dto.Rate{Amount: 2000, Currency: "USD"},
+					CostRate:   &dto.Rate{Amount: 500, Currency: "USD"},
+				}}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.WithEarnings = true
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Docf(`
+				id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,earnings.currency,earnings.earned,earnings.cost,earnings.profit,tags...,customFields...
+				te-1,,,,,,%s,%s,2:00:00,,,,USD,40.00,10.00,30.00,,
+			`,
+				first.In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(2*time.Hour).In(time.Local).
+					Format(timehlp.FullTimeFormat),
+			),
+		},
 		{
 			name: "not billable only",
 			factory: func(t *testing.T) cmdutil.Factory {
@@ -389,6 +523,7 @@ func TestReportWithRange(t *testing.T) {
 				f.EXPECT().Config().Return(&mocks.SimpleConfig{})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -427,6 +562,7 @@ func TestReportWithRange(t *testing.T) {
 				})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				tag := dto.Tag{ID: "t1", Name: "Client"}
@@ -474,6 +610,7 @@ func TestReportWithRange(t *testing.T) {
 					&mocks.SimpleConfig{AllowNameForID: true})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.EXPECT().GetProjects(api.GetProjectsParam{
@@ -557,6 +694,7 @@ func TestReportWithRange(t *testing.T) {
 					&mocks.SimpleConfig{AllowNameForID: true})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.EXPECT().GetClients(api.GetClientsParam{
@@ -634,6 +772,7 @@ func TestReportWithRange(t *testing.T) {
 				})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.On("LogRange", api.LogRangeParam{
@@ -685,6 +824,7 @@ func TestReportWithRange(t *testing.T) {
 					&mocks.SimpleConfig{AllowNameForID: true})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.EXPECT().LogRange(api.LogRangeParam{
@@ -735,6 +875,7 @@ func TestReportWithRange(t *testing.T) {
 					&mocks.SimpleConfig{AllowNameForID: true})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.EXPECT().GetClients(api.GetClientsParam{
@@ -818,6 +959,7 @@ func TestReportWithRange(t *testing.T) {
 					&mocks.SimpleConfig{AllowNameForID: true})
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.On("Client").Return(c, nil)
 
 				c.EXPECT().GetClients(api.GetClientsParam{
@@ -899,6 +1041,7 @@ func TestReportWithRange(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			b := bytes.NewBufferString("")
 			err := util.ReportWithRange(
+				context.Background(),
 				tt.factory(t),
 				date,
 				date.AddDate(0, 0, 2),
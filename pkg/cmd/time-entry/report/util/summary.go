@@ -0,0 +1,31 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/summary"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// printSummary prints the time entries grouped by the dimensions of
+// ReportFlags.GroupBy
+func printSummary(
+	cnf cmdutil.Config, tes []dto.TimeEntry, out io.Writer, rf ReportFlags,
+) error {
+	s := summary.New(tes, summary.Options{
+		GroupBy:  rf.GroupBy,
+		Location: cnf.TimeZone(),
+		Now:      timehlp.Now(),
+	})
+
+	switch {
+	case rf.JSON:
+		return summary.SummaryJSONPrint(s, out)
+	case rf.CSV:
+		return summary.SummaryCSVPrint(s, out)
+	default:
+		return summary.SummaryPrint(s, out)
+	}
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/timesheet"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// printTimesheet prints the time entries as a grid of projects by day
+func printTimesheet(
+	cnf cmdutil.Config, tes []dto.TimeEntry, start, end time.Time,
+	out io.Writer, rf ReportFlags,
+) error {
+	ts := timesheet.New(tes, timesheet.Options{
+		First:    start,
+		Last:     end,
+		ByTask:   rf.TimesheetByTask,
+		Workdays: cnf.GetWorkWeekdays(),
+		Location: cnf.TimeZone(),
+		Now:      timehlp.Now(),
+	})
+
+	switch {
+	case rf.JSON:
+		return timesheet.TimesheetJSONPrint(ts, out)
+	case rf.CSV:
+		return timesheet.TimesheetCSVPrint(ts, out)
+	case rf.Markdown:
+		return timesheet.TimesheetMarkdownPrint(ts, out)
+	default:
+		return timesheet.TimesheetPrint(ts, out)
+	}
+}
@@ -19,7 +19,7 @@ func NewCmdYesterday(f cmdutil.Factory) *cobra.Command {
 			}
 
 			day := timehlp.Today().Add(-1)
-			return util.ReportWithRange(f, day, day, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, day, day, cmd.OutOrStdout(), of)
 		},
 	}
 
@@ -0,0 +1,101 @@
+package snap
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+var modes = cmdcompl.ValidArgsSlide{
+	timeentryhlp.RoundNearest,
+	timeentryhlp.RoundUp,
+	timeentryhlp.RoundDown,
+}
+
+// NewCmdSnap represents the snap command
+func NewCmdSnap(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng     string
+		minutes int
+		mode    string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "snap",
+		Args:  cobra.NoArgs,
+		Short: "Rounds the start and end of time entries",
+		Long: heredoc.Doc(`
+			Rounds the start and end of the time entries of a range using the rounding settings of the workspace, or --minutes and --mode if informed.
+
+			Running time entries only have their start rounded, and time entries that would be left without duration or are locked are not changed.
+
+			The changes are always shown before being made, use --dry-run to only show them.
+
+			The ranges accepted are:
+		`) + cmdcompl.ValidArgsMap(timehlp.NamedRanges).Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli snap --minutes 15 --mode nearest
+			~ 62b87a9785815e619d7ce02e 2026-10-17 09:03 - 10:26 => 2026-10-17 09:00 - 10:30: rounded nearest to 15m0s
+			1 time entries were updated, 0 deleted
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			w, err := f.GetWorkspace()
+			if err != nil {
+				return err
+			}
+
+			r := w.Settings.Round
+			if minutes != 0 {
+				r.Minutes = strconv.Itoa(minutes)
+			}
+			if mode != "" {
+				r.Round = mode
+			}
+
+			if r.Minutes == "" || r.Minutes == "0" {
+				return errors.New("workspace has no rounding set, " +
+					"use --minutes to set it")
+			}
+
+			m, step, err := timeentryhlp.ParseRound(r)
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := util.LogNamedRange(f, c, rng)
+			if err != nil {
+				return err
+			}
+
+			return util.ApplyChanges(cmd.Context(), f, c, cmd.OutOrStdout(),
+				timeentryhlp.Snaps(tes, m, step), dryRun)
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "today",
+		"range of days to round")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range",
+		cmdcompl.ValidArgsMap(timehlp.NamedRanges))
+	cmd.Flags().IntVar(&minutes, "minutes", 0,
+		"round to multiples of these minutes, instead of the workspace's")
+	cmd.Flags().StringVar(&mode, "mode", "",
+		"round mode, instead of the workspace's")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "mode", modes)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the changes that would be made")
+
+	return cmd
+}
@@ -146,9 +146,12 @@ func NewCmdSplit(
 				return err
 			}
 
+			eg, ctx := errgroup.WithContext(cmd.Context())
+			gc := c.WithContext(ctx)
+
 			tes := make([]dto.TimeEntry, len(splits)+1)
 			getHydrated := func(i int, id string) error {
-				t, err := c.GetHydratedTimeEntry(api.GetTimeEntryParam{
+				t, err := gc.GetHydratedTimeEntry(api.GetTimeEntryParam{
 					TimeEntryID: id,
 					Workspace:   w,
 				})
@@ -160,7 +163,6 @@ func NewCmdSplit(
 				return nil
 			}
 
-			eg := errgroup.Group{}
 			eg.Go(func() error { return getHydrated(0, te.ID) })
 
 			for i := range splits {
@@ -171,7 +173,7 @@ func NewCmdSplit(
 						end = &splits[i+1]
 					}
 
-					te, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+					te, err := gc.CreateTimeEntry(api.CreateTimeEntryParam{
 						Workspace:   te.WorkspaceID,
 						Billable:    &te.Billable,
 						Start:       splits[i],
@@ -14,6 +14,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewCmdSplitShouldFail(t *testing.T) {
@@ -39,6 +40,7 @@ func TestNewCmdSplitShouldFail(t *testing.T) {
 		f.EXPECT().GetUserID().Return(w.ID, nil)
 
 		c := mocks.NewMockClient(t)
+		c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 		f.EXPECT().Client().Return(c, nil)
 
 		c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
@@ -89,6 +91,7 @@ func TestNewCmdSplitShouldFail(t *testing.T) {
 				f.EXPECT().GetUserID().Return(w.ID, nil)
 
 				c := mocks.NewMockClient(t)
+				c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 				f.EXPECT().Client().Return(c, nil)
 
 				c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
@@ -178,6 +181,7 @@ func TestNewCmdSplitShouldCreateNewEntries(t *testing.T) {
 		f.EXPECT().GetUserID().Return(w.ID, nil)
 
 		c := mocks.NewMockClient(t)
+		c.EXPECT().WithContext(mock.Anything).Return(c).Maybe()
 		f.EXPECT().Client().Return(c, nil)
 
 		c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
@@ -0,0 +1,166 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// DefaultFormat is used to print the status when no format is set
+const DefaultFormat = "{{.Project}} {{.Elapsed}}"
+
+// Status is the time entry running, as available to the format
+type Status struct {
+	ID          string
+	Description string
+	Project     string
+	Client      string
+	Task        string
+	Tags        []string
+	Billable    bool
+	Start       time.Time
+	// Elapsed is how long the time entry is running, like 1:05
+	Elapsed  string
+	Duration dto.Duration
+}
+
+// NewCmdStatus represents the status command
+func NewCmdStatus(f cmdutil.Factory) *cobra.Command {
+	var (
+		format  string
+		none    string
+		refresh bool
+	)
+
+	cmd := &cobra.Command{
+		Use:  "status",
+		Args: cobra.NoArgs,
+		Short: "Shows the time entry running in a compact format, " +
+			"for shell prompts",
+		Long: heredoc.Docf(`
+			Shows the time entry running using --format, or --none if there is no time entry running.
+
+			To be fast enough to be used on shell prompts and status bars, the time entry running is kept on the file ~/.config/clockify-cli/running.json. After this command is used for the first time (or when the config "%[1]s" is set), the CLI updates this file when time entries are started, stopped, changed or deleted by it. The time entry running is checked on the API only when the file is older than the config "%[1]s" (default is %[2]s), to consider changes made elsewhere, after the CLI starts or changes it, to load the names of its project, task and tags, or when using --refresh.
+
+			The fields available to the format are: ID, Description, Project, Client, Task, Tags, Billable, Start, Elapsed (like 1:05) and Duration (use {{.Duration.HumanString}} for 1:05:12).
+		`,
+			cmdutil.CONF_STATUS_TTL,
+			dto.Duration{Duration: cmdutil.DEFAULT_STATUS_TTL}.HumanString(),
+		),
+		Example: heredoc.Doc(`
+			$ clockify-cli status
+			Clockify CLI 1:05
+
+			$ clockify-cli status --format '{{.Project}}: {{.Description}} ({{.Elapsed}})' --none 'not tracking'
+			Clockify CLI: Writing docs (1:05)
+
+			# starship, on ~/.config/starship.toml
+			[custom.clockify]
+			command = "clockify-cli status"
+			when = true
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			t, err := util.NewTemplate(format)
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			s, err := f.Running()
+			if err != nil {
+				return err
+			}
+
+			st, fresh := s.Get(w, u)
+			if refresh || !fresh {
+				c, err := f.Client()
+				if err != nil {
+					return err
+				}
+
+				te, err := c.GetHydratedTimeEntryInProgress(
+					api.GetTimeEntryInProgressParam{
+						Workspace: w,
+						UserID:    u,
+					})
+				if err != nil {
+					return err
+				}
+
+				st.TimeEntry = te
+				// failing to store it only makes the next call slower
+				_ = s.Set(w, u, te)
+			}
+
+			out := cmd.OutOrStdout()
+			if st.TimeEntry == nil {
+				if none == "" {
+					return nil
+				}
+
+				_, err := fmt.Fprintln(out, none)
+				return err
+			}
+
+			return t.Execute(out, newStatus(
+				*st.TimeEntry, f.Config().TimeZone(), timehlp.Now()))
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", DefaultFormat,
+		"golang text/template format to show the time entry running")
+	cmd.Flags().StringVar(&none, "none", "",
+		"text to show when there is no time entry running")
+	cmd.Flags().BoolVarP(&refresh, "refresh", "r", false,
+		"check the time entry running on the API, even if the local one "+
+			"is fresh")
+
+	return cmd
+}
+
+func newStatus(te dto.TimeEntry, tz *time.Location, now time.Time) Status {
+	s := Status{
+		ID:          te.ID,
+		Description: te.Description,
+		Billable:    te.Billable,
+		Start:       te.TimeInterval.Start.In(tz),
+		Duration:    dto.Duration{Duration: now.Sub(te.TimeInterval.Start)},
+	}
+
+	if te.Project != nil {
+		s.Project = te.Project.Name
+		s.Client = te.Project.ClientName
+	}
+
+	if te.Task != nil {
+		s.Task = te.Task.Name
+	}
+
+	for _, t := range te.Tags {
+		s.Tags = append(s.Tags, t.Name)
+	}
+
+	d := s.Duration.Truncate(time.Minute)
+	if d < 0 {
+		d = 0
+	}
+	s.Elapsed = fmt.Sprintf("%d:%02d", int(d.Hours()), int(d.Minutes())%60)
+
+	return s
+}
@@ -0,0 +1,123 @@
+package status_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/status"
+	"github.com/lucassabreu/clockify-cli/pkg/running"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdStatus(t *testing.T) {
+	te := &dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		Project:     &dto.Project{Name: "Clockify CLI", ClientName: "Me"},
+		Task:        &dto.Task{Name: "Docs"},
+		Tags:        []dto.Tag{{Name: "dev"}, {Name: "cli"}},
+		TimeInterval: dto.NewTimeInterval(timehlp.Now().Add(
+			-(65*time.Minute + 30*time.Second)), nil),
+	}
+
+	tts := []struct {
+		name     string
+		args     []string
+		stored   *dto.TimeEntry
+		fresh    bool
+		fetched  *dto.TimeEntry
+		expected string
+	}{
+		{
+			name:     "fresh state",
+			stored:   te,
+			fresh:    true,
+			expected: "Clockify CLI 1:05\n",
+		},
+		{
+			name:     "fresh state without time entry",
+			fresh:    true,
+			expected: "",
+		},
+		{
+			name:     "stale state",
+			stored:   nil,
+			fetched:  te,
+			expected: "Clockify CLI 1:05\n",
+		},
+		{
+			name:     "refresh",
+			args:     []string{"-r", "--none", "not tracking"},
+			stored:   te,
+			fresh:    true,
+			fetched:  nil,
+			expected: "not tracking\n",
+		},
+		{
+			name: "format",
+			args: []string{"-f", "{{.Client}}/{{.Project}}/{{.Task}}: " +
+				"{{.Description}} {{.Tags}} ({{.Elapsed}})"},
+			stored:   te,
+			fresh:    true,
+			expected: "Me/Clockify CLI/Docs: Writing docs [dev cli] (1:05)\n",
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			if !tt.fresh {
+				now = now.Add(-2 * time.Hour)
+			}
+			s := running.NewStore(
+				filepath.Join(t.TempDir(), "running.json"), time.Hour,
+				running.WithNow(func() time.Time { return now }))
+			if err := s.Set("w", "u", tt.stored); err != nil {
+				t.Fatal(err)
+			}
+			now = time.Now()
+
+			f := mocks.NewMockFactory(t)
+			f.On("GetWorkspaceID").Return("w", nil)
+			f.On("GetUserID").Return("u", nil)
+			f.On("Running").Return(s, nil)
+			f.On("Config").Return(&mocks.SimpleConfig{}).Maybe()
+
+			fetch := !tt.fresh || len(tt.args) > 0 && tt.args[0] == "-r"
+			if fetch {
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.EXPECT().GetHydratedTimeEntryInProgress(
+					api.GetTimeEntryInProgressParam{
+						Workspace: "w",
+						UserID:    "u",
+					}).Return(tt.fetched, nil).Once()
+			}
+
+			out := &bytes.Buffer{}
+			cmd := status.NewCmdStatus(f)
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(out)
+
+			_, err := cmd.ExecuteC()
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.expected, out.String())
+
+			if fetch {
+				st, _ := s.Get("w", "u")
+				assert.Equal(t, tt.fetched, st.TimeEntry,
+					"fetched time entry should be stored")
+			}
+		})
+	}
+}
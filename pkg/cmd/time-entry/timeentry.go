@@ -4,16 +4,26 @@ import (
 	"io"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/balance"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/clone"
 	del "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/delete"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/fill"
+	imp "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/in"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/invoiced"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/lint"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/manual"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/merge"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/out"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/pomodoro"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/show"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/snap"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/split"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/status"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/timewarrior"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/trim"
 	teutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -35,8 +45,17 @@ func NewCmdTimeEntry(f cmdutil.Factory) (cmds []*cobra.Command) {
 		cmds,
 
 		in.NewCmdIn(f, rFn),
+		pomodoro.NewCmdPomodoro(f, rFn),
 		manual.NewCmdManual(f),
 		clone.NewCmdClone(f),
+		imp.NewCmdImport(f),
+		timewarrior.NewCmdTimewarrior(f),
+		fill.NewCmdFill(f),
+		lint.NewCmdLint(f),
+		merge.NewCmdMerge(f),
+		trim.NewCmdTrim(f),
+		snap.NewCmdSnap(f),
+		balance.NewCmdBalance(f),
 
 		edit.NewCmdEdit(f, rFn),
 
@@ -47,6 +66,7 @@ func NewCmdTimeEntry(f cmdutil.Factory) (cmds []*cobra.Command) {
 		del.NewCmdDelete(f),
 
 		show.NewCmdShow(f),
+		status.NewCmdStatus(f),
 		report.NewCmdReport(f),
 	)
 
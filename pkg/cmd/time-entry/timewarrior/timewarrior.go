@@ -0,0 +1,81 @@
+package timewarrior
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	imp "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTimewarrior represents the timewarrior command
+func NewCmdTimewarrior(f cmdutil.Factory) *cobra.Command {
+	var (
+		mapping string
+		dryRun  bool
+		force   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "timewarrior",
+		Aliases: []string{"timew"},
+		Args:    cobra.NoArgs,
+		Short:   "Acts as a Timewarrior extension, importing the intervals of a report",
+		Long: heredoc.Docf(`
+			Acts as a Timewarrior extension, reading the configuration and intervals sent by Timewarrior on stdin and importing them as time entries.
+
+			To use it, create a executable file on the extensions folder of Timewarrior (usually ~/.timewarrior/extensions/clockify) with:
+			  #!/bin/sh
+			  exec clockify-cli timewarrior
+
+			Then "timew report clockify" will import the intervals of the range informed (by default, all of them) into Clockify.
+
+			The annotation of the intervals is used as description and their tags as tags. To map tags to projects, set configs on Timewarrior like:
+			  timew config %[1]swebsite "Website"
+			or set the path of a mapping file (see "clockify-cli import --help") on the config "%[2]s" (or use --mapping).
+
+			As on "import", each interval imported is recorded on a local ledger, so reporting the same range again will only import the new ones, and the intervals that are still open are not imported.
+		`, importer.TimewarriorProjectConfig, importer.TimewarriorMappingConfig),
+		Example: heredoc.Doc(`
+			$ timew report clockify :week
+			+ line 1: 2026-10-12 09:00:00 - 10:30:00 (1:30:00) Website: Planning [dev]
+			= line 2: already imported as 62b87a9785815e619d7ce02e
+			! line 3: interval is still open
+			1 time entries were imported, 1 already imported
+			Error: 1 of 3 time entries failed to be imported
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rep, err := importer.ParseTimewarriorReport(cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			var m importer.Mapping
+			if mapping != "" {
+				m, err = importer.ReadMapping(mapping)
+			} else {
+				m, err = rep.Mapping()
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return imp.Import(f, cmd.OutOrStdout(), rep.Entries, imp.Options{
+				Mapping: m,
+				DryRun:  dryRun,
+				Force:   force,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&mapping, "mapping", "m", "",
+		"YAML file mapping the names of projects, clients and tags "+
+			"(replaces the mapping set on Timewarrior)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the time entries that would be imported")
+	cmd.Flags().BoolVar(&force, "force", false,
+		"import time entries even if they were already imported")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package timewarrior_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/timewarrior"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+const report = `temp.report.start: 20261014T000000Z
+clockify.project.website: Website
+
+[
+{"id":2,"start":"20261014T120000Z","end":"20261014T133000Z","tags":["website","dev"],"annotation":"Planning"},
+{"id":1,"start":"20261014T140000Z","tags":["website"]}
+]
+`
+
+func TestCmdTimewarrior(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.On("ImportLedger").Return(importer.NewLedger(
+		filepath.Join(t.TempDir(), "import-ledger")), nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspace").Return(dto.Workspace{ID: "w"}, nil)
+
+	cf := mocks.NewMockConfig(t)
+	f.On("Config").Return(cf)
+	cf.On("GetBool", cmdutil.CONF_ALLOW_INCOMPLETE).Return(false)
+	cf.On("IsSearchProjectWithClientsName").Return(false)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	c.On("GetProjects", api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Project{{ID: "p1", Name: "Website"}}, nil)
+	c.On("GetProject", api.GetProjectParam{
+		Workspace: "w",
+		ProjectID: "p1",
+	}).Return(&dto.Project{ID: "p1", Name: "Website"}, nil)
+	c.On("GetTags", api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Tag{{ID: "tag1", Name: "Dev"}}, nil).Once()
+
+	end := time.Date(2026, 10, 14, 13, 30, 0, 0, time.UTC)
+	c.On("CreateTimeEntry", api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+		End:         &end,
+		Description: "Planning",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tag1"},
+	}).Return(dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+
+	b := &bytes.Buffer{}
+	cmd := timewarrior.NewCmdTimewarrior(f)
+	cmd.SetArgs([]string{})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetIn(strings.NewReader(report))
+	cmd.SetOut(b)
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "1 of 2 time entries failed to be imported")
+	assert.Equal(t, heredoc.Doc(`
+		! line 2: interval is still open
+		1 time entries were imported
+	`), strings.SplitN(b.String(), "\n", 2)[1])
+
+	b.Reset()
+	cmd.SetIn(strings.NewReader(report))
+
+	_, err = cmd.ExecuteC()
+	assert.EqualError(t, err, "1 of 2 time entries failed to be imported")
+	assert.Contains(t, b.String(), "= line 1: already imported as te1\n")
+}
@@ -0,0 +1,62 @@
+package trim
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTrim represents the trim command
+func NewCmdTrim(f cmdutil.Factory) *cobra.Command {
+	var (
+		rng    string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "trim",
+		Args:  cobra.NoArgs,
+		Short: "Removes the overlaps between time entries",
+		Long: heredoc.Doc(`
+			Removes the overlaps between time entries by moving the start of a time entry to the end of the ones that started before it.
+
+			Time entries entirely inside another one and locked time entries are not changed, use "clockify-cli lint" to find them.
+
+			The changes are always shown before being made, use --dry-run to only show them.
+
+			The ranges accepted are:
+		`) + cmdcompl.ValidArgsMap(timehlp.NamedRanges).Long(),
+		Example: heredoc.Doc(`
+			$ clockify-cli trim --range this-week --dry-run
+			~ 62b87ab285815e619d7ce0a1 2026-10-14 10:00 - 11:00 => 2026-10-14 10:30 - 11:00: overlapped 62b87a9785815e619d7ce02e
+			1 time entries would be updated, 0 deleted
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := util.LogNamedRange(f, c, rng)
+			if err != nil {
+				return err
+			}
+
+			return util.ApplyChanges(cmd.Context(), f, c, cmd.OutOrStdout(),
+				timeentryhlp.Trims(tes, timehlp.Now()), dryRun)
+		},
+	}
+
+	cmd.Flags().StringVarP(&rng, "range", "r", "today",
+		"range of days to trim")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "range",
+		cmdcompl.ValidArgsMap(timehlp.NamedRanges))
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the changes that would be made")
+
+	return cmd
+}
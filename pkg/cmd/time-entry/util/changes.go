@@ -0,0 +1,201 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"golang.org/x/sync/errgroup"
+)
+
+// changesBatchSize is how many changes are sent to the API at the same time
+const changesBatchSize = 5
+
+// ApplyChanges shows the changes and, unless dryRun is set, updates and
+// deletes the time entries, asking for confirmation in interactive mode
+func ApplyChanges(
+	ctx context.Context,
+	f cmdutil.Factory,
+	c api.Client,
+	out io.Writer,
+	cs []timeentryhlp.Change,
+	dryRun bool,
+) error {
+	if len(cs) == 0 {
+		_, err := fmt.Fprintln(out, "there is nothing to change")
+		return err
+	}
+
+	updates, deletes := 0, 0
+	for _, ch := range cs {
+		if ch.Delete {
+			deletes++
+		} else {
+			updates++
+		}
+
+		if err := printChange(out, ch); err != nil {
+			return err
+		}
+	}
+
+	verb := "were"
+	if dryRun {
+		verb = "would be"
+	}
+	summary := fmt.Sprintf("%d time entries %s updated, %d deleted\n",
+		updates, verb, deletes)
+
+	if dryRun {
+		_, err := fmt.Fprint(out, summary)
+		return err
+	}
+
+	if f.Config().IsInteractive() {
+		ok, err := f.UI().Confirm(fmt.Sprintf(
+			"Change these %d time entries?", len(cs)), true)
+		if err != nil || !ok {
+			return err
+		}
+	}
+
+	// time entries are only deleted after all updates succeed, so merged
+	// time is not lost when updating the time entry that keeps it fails
+	for _, deletes := range []bool{false, true} {
+		if err := applyChanges(ctx, c, cs, deletes); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprint(out, summary)
+	return err
+}
+
+// applyChanges sends the updates or the deletions of the changes, in batches
+func applyChanges(
+	ctx context.Context, c api.Client, cs []timeentryhlp.Change, deletes bool,
+) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(changesBatchSize)
+	gc := c.WithContext(ctx)
+
+	for _, ch := range cs {
+		if ch.Delete != deletes {
+			continue
+		}
+
+		ch := ch
+		eg.Go(func() error { return applyChange(gc, ch) })
+	}
+
+	return eg.Wait()
+}
+
+func printChange(out io.Writer, ch timeentryhlp.Change) error {
+	const dateTime = "2006-01-02 15:04"
+	te := ch.TimeEntry
+	s := te.TimeInterval.Start.Local()
+
+	oe := "now"
+	if te.TimeInterval.End != nil {
+		oe = te.TimeInterval.End.Local().Format(endFormat(s, *te.TimeInterval.End))
+	}
+
+	if ch.Delete {
+		_, err := fmt.Fprintf(out, "- %s %s - %s: %s\n",
+			te.ID, s.Format(dateTime), oe, ch.Reason)
+		return err
+	}
+
+	ns := ch.Start.Local()
+	ne := "now"
+	if te.TimeInterval.End != nil {
+		ne = ch.End.Local().Format(endFormat(ns, ch.End))
+	}
+
+	_, err := fmt.Fprintf(out, "~ %s %s - %s => %s - %s: %s\n",
+		te.ID, s.Format(dateTime), oe, ns.Format(dateTime), ne, ch.Reason)
+	return err
+}
+
+// endFormat only shows the date of the end when it is not the day of start
+func endFormat(start, end time.Time) string {
+	if start.Local().Format("2006-01-02") != end.Local().Format("2006-01-02") {
+		return "2006-01-02 15:04"
+	}
+
+	return "15:04"
+}
+
+func applyChange(c api.Client, ch timeentryhlp.Change) error {
+	te := ch.TimeEntry
+	if ch.Delete {
+		return c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+			Workspace:   te.WorkspaceID,
+			TimeEntryID: te.ID,
+		})
+	}
+
+	var end *time.Time
+	if te.TimeInterval.End != nil {
+		end = &ch.End
+	}
+
+	tagIDs := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		tagIDs[i] = te.Tags[i].ID
+	}
+
+	taskID := ""
+	if te.Task != nil {
+		taskID = te.Task.ID
+	}
+
+	_, err := c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace:   te.WorkspaceID,
+		TimeEntryID: te.ID,
+		Start:       ch.Start,
+		End:         end,
+		Billable:    te.Billable,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TaskID:      taskID,
+		TagIDs:      tagIDs,
+	})
+	return err
+}
+
+// LogNamedRange returns the time entries of the user on the days of the
+// range informed (see timehlp.NamedRanges)
+func LogNamedRange(
+	f cmdutil.Factory, c api.Client, rng string,
+) ([]dto.TimeEntry, error) {
+	first, last, err := timehlp.GetNamedRange(rng, timehlp.Today())
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := f.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.LogRange(api.LogRangeParam{
+		Workspace:       w,
+		UserID:          u,
+		FirstDate:       first,
+		LastDate:        last.AddDate(0, 0, 1),
+		PaginationParam: api.AllPages(),
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +19,7 @@ type OutputFlags struct {
 	JSON              bool
 	Quiet             bool
 	Markdown          bool
+	ICS               bool
 	DurationFormatted bool
 	DurationFloat     bool
 	TimeFormat        string
@@ -30,6 +32,7 @@ func (of OutputFlags) Check() error {
 		"csv":                of.CSV,
 		"quiet":              of.Quiet,
 		"md":                 of.Markdown,
+		"ics":                of.ICS,
 		"duration-float":     of.DurationFloat,
 		"duration-formatted": of.DurationFormatted,
 	})
@@ -54,6 +57,8 @@ func AddPrintTimeEntriesFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
 	cmd.Flags().BoolVarP(&of.Markdown, "md", "m", false, "print as Markdown")
+	cmd.Flags().BoolVar(&of.ICS, "ics", false,
+		"print as iCalendar (.ics), with each time entry as a event")
 	cmd.Flags().BoolVarP(&of.DurationFormatted, "duration-formatted", "D", false,
 		"prints only the sum of duration formatted")
 	cmd.Flags().BoolVarP(&of.DurationFloat, "duration-float", "F", false,
@@ -123,15 +128,42 @@ func updateTimeZone(tes []dto.TimeEntry, config cmdutil.Config) []dto.TimeEntry
 // flags
 func PrintTimeEntries(
 	tes []dto.TimeEntry, out io.Writer, config cmdutil.Config, of OutputFlags,
+) error {
+	return PrintTimeEntriesWithEarnings(tes, nil, out, config, of)
+}
+
+// PrintTimeEntriesWithEarnings will print out a list of time entries using
+// parameters and flags, showing the earnings of them on the table, markdown,
+// CSV and JSON outputs when es is not nil
+func PrintTimeEntriesWithEarnings(
+	tes []dto.TimeEntry, es ratehlp.Earnings,
+	out io.Writer, config cmdutil.Config, of OutputFlags,
 ) error {
 	tes = updateTimeZone(tes, config)
+
+	var eo *output.EarningsOptions
+	if es != nil {
+		eo = &output.EarningsOptions{
+			Earnings: es,
+			Language: config.Language(),
+		}
+	}
+
 	switch {
+	case of.Markdown && eo != nil:
+		return output.TimeEntriesMarkdownPrintWithEarnings(tes, *eo, out)
 	case of.Markdown:
 		return output.TimeEntriesMarkdownPrint(tes, out)
+	case of.JSON && eo != nil:
+		return output.TimeEntriesJSONPrintWithEarnings(tes, *eo, out)
 	case of.JSON:
 		return output.TimeEntriesJSONPrint(tes, out)
+	case of.CSV && eo != nil:
+		return output.TimeEntriesCSVPrintWithEarnings(tes, *eo, out)
 	case of.CSV:
 		return output.TimeEntriesCSVPrint(tes, out)
+	case of.ICS:
+		return output.TimeEntriesICSPrint(tes, out)
 	case of.Format != "":
 		return output.TimeEntriesPrintWithTemplate(of.Format)(tes, out)
 	case of.Quiet:
@@ -161,6 +193,10 @@ func PrintTimeEntries(
 			opts = opts.WithTotalDuration()
 		}
 
+		if eo != nil {
+			opts = opts.WithEarnings(*eo)
+		}
+
 		return output.TimeEntriesPrint(opts)(tes, out)
 	}
 }
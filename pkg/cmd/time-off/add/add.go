@@ -0,0 +1,92 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(f cmdutil.Factory) *cobra.Command {
+	var t worktime.TimeOff
+
+	cmd := &cobra.Command{
+		Use:     "add <date> [<last-date>]",
+		Aliases: []string{"new", "create"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Saves holidays and days off",
+		Long: heredoc.Doc(`
+			Saves a holiday or day off, or every day from the date until the last date (inclusive), replacing the ones already saved on the same days.
+
+			By default nothing is expected to be worked on the days, use --half to expect half of the usual hours, or --hours to set how long is expected.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli time-off add 2026-12-25 --name Christmas
+			$ clockify-cli time-off add 2026-12-24 --half --name "Christmas Eve"
+			$ clockify-cli time-off add 2026-07-06 2026-07-17 --name Vacation
+		`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"half":  t.Half,
+				"hours": t.Hours != "",
+			}); err != nil {
+				return err
+			}
+
+			first, err := parseDate(args[0])
+			if err != nil {
+				return err
+			}
+
+			last := first
+			if len(args) > 1 {
+				if last, err = parseDate(args[1]); err != nil {
+					return err
+				}
+
+				if last.Before(first) {
+					return errors.New("last date must be after the date")
+				}
+			}
+
+			t.Name = strings.TrimSpace(t.Name)
+			var ts []worktime.TimeOff
+			for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+				t.Date = d.Format(worktime.DateFormat)
+				ts = append(ts, t)
+			}
+
+			cal, err := f.TimeOff()
+			if err != nil {
+				return err
+			}
+
+			return cal.Add(ts...)
+		},
+	}
+
+	cmd.Flags().StringVarP(&t.Name, "name", "n", "",
+		"name of the holiday or day off")
+	cmd.Flags().BoolVar(&t.Half, "half", false,
+		"half of the usual hours are expected to be worked on the days")
+	cmd.Flags().StringVar(&t.Hours, "hours", "",
+		"how long is expected to be worked on the days (like 4h)")
+
+	return cmd
+}
+
+func parseDate(s string) (time.Time, error) {
+	d, err := time.ParseInLocation(worktime.DateFormat, s, time.Local)
+	if err != nil {
+		return d, fmt.Errorf(
+			"date \"%s\" is invalid, use a date like \"2026-12-25\"", s)
+	}
+
+	return d, nil
+}
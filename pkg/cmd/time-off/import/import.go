@@ -0,0 +1,168 @@
+package imp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// formats of the files to import
+const (
+	formatICS  = "ics"
+	formatYAML = "yaml"
+)
+
+// NewCmdImport represents the import command
+func NewCmdImport(f cmdutil.Factory) *cobra.Command {
+	var (
+		from   string
+		half   bool
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import { <file> | - }",
+		Args:  cobra.ExactArgs(1),
+		Short: "Imports holidays and days off from ICS or YAML files",
+		Long: heredoc.Doc(`
+			Imports holidays and days off from an iCalendar file (like the public holidays calendars) or a YAML list, replacing the ones already saved on the same days.
+
+			The format of the file is detected by its extension (.ics or .yaml/.yml), or can be set with --from (required when reading from stdin using "-").
+
+			Only all-day events of iCalendar files are imported, one day off for each day of the event, named after its summary; events with times or that repeat are skipped.
+
+			YAML files must have a list of days off, with the fields: date, name, hours (how long is expected to be worked on the day) and half (true when half of the usual hours are expected), like:
+			  - date: 2026-12-24
+			    name: Christmas Eve
+			    half: true
+			  - date: 2026-12-25
+			    name: Christmas
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli time-off import holidays.ics --dry-run
+			+ 2026-12-25 Christmas
+			+ 2027-01-01 New Year's Day
+			2 days off would be imported, 1 events were skipped
+
+			$ curl -s https://example.com/holidays.ics | clockify-cli time-off import - --from ics
+			$ clockify-cli time-off import carnival.yaml --half
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file := args[0]
+			if from == "" {
+				if file == "-" {
+					return cmdutil.FlagErrorWrap(errors.New(
+						"--from is required when reading from stdin"))
+				}
+
+				var err error
+				if from, err = detectFormat(file); err != nil {
+					return err
+				}
+			}
+
+			var (
+				b   []byte
+				err error
+			)
+			if file == "-" {
+				b, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				b, err = os.ReadFile(file)
+			}
+			if err != nil {
+				return errors.Wrap(err, "read "+file)
+			}
+
+			var (
+				ts      []worktime.TimeOff
+				skipped int
+			)
+			switch from {
+			case formatICS:
+				ts, skipped, err = worktime.ParseICS(bytes.NewReader(b))
+			case formatYAML:
+				ts, err = worktime.ParseYAML(b)
+			default:
+				return cmdutil.FlagErrorWrap(
+					errors.New("format \"" + from + "\" is invalid"))
+			}
+			if err != nil {
+				return errors.Wrap(err, "read "+file)
+			}
+
+			if half {
+				for i := range ts {
+					ts[i].Half = true
+					ts[i].Hours = ""
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			for _, t := range ts {
+				if _, err := fmt.Fprintln(out, "+", t.Date, t.Name); err != nil {
+					return err
+				}
+			}
+
+			verb := "were"
+			if dryRun {
+				verb = "would be"
+			} else {
+				cal, err := f.TimeOff()
+				if err != nil {
+					return err
+				}
+
+				if err := cal.Add(ts...); err != nil {
+					return err
+				}
+			}
+
+			_, err = fmt.Fprintf(out, "%d days off %s imported", len(ts), verb)
+			if err == nil && skipped > 0 {
+				_, err = fmt.Fprintf(out, ", %d events were skipped", skipped)
+			}
+			if err == nil {
+				_, err = fmt.Fprintln(out)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "",
+		"format of the file (ics or yaml), detected by the extension if "+
+			"not set")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "from",
+		cmdcompl.ValidArgsSlide{formatICS, formatYAML})
+	cmd.Flags().BoolVar(&half, "half", false,
+		"half of the usual hours are expected to be worked on the days "+
+			"imported")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only show the days off that would be imported")
+
+	return cmd
+}
+
+func detectFormat(file string) (string, error) {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".ics", ".ical", ".ifb":
+		return formatICS, nil
+	case ".yaml", ".yml":
+		return formatYAML, nil
+	default:
+		return "", cmdutil.FlagErrorWrap(errors.New(
+			"can't detect the format of \"" + file + "\", use --from"))
+	}
+}
@@ -0,0 +1,142 @@
+package imp_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	imp "github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/import"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdImport(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	ics := heredoc.Doc(`
+		BEGIN:VCALENDAR
+		BEGIN:VEVENT
+		DTSTART;VALUE=DATE:20261225
+		SUMMARY:Christmas
+		END:VEVENT
+		BEGIN:VEVENT
+		DTSTART:20261224T130000Z
+		SUMMARY:Party
+		END:VEVENT
+		END:VCALENDAR
+	`)
+	yaml := heredoc.Doc(`
+		- date: 2026-02-16
+		  name: Carnival
+		- date: 2026-02-17
+		  name: Carnival
+		  hours: 2h
+	`)
+
+	tts := []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected string
+		saved    []worktime.TimeOff
+		err      string
+	}{
+		{
+			name: "stdin without format",
+			args: []string{"-"},
+			err:  "--from is required when reading from stdin",
+		},
+		{
+			name: "unknown extension",
+			args: []string{"holidays.txt"},
+			err: "can't detect the format of \"holidays.txt\", " +
+				"use --from",
+		},
+		{
+			name: "invalid file",
+			args: []string{write("invalid.yaml", "- date: 16/02/2026\n")},
+			err: "read " + filepath.Join(dir, "invalid.yaml") + ": " +
+				"date \"16/02/2026\" is invalid, use a date like \"2026-12-25\"",
+		},
+		{
+			name: "ics dry run",
+			args: []string{write("holidays.ics", ics), "--dry-run"},
+			expected: heredoc.Doc(`
+				+ 2026-12-25 Christmas
+				1 days off would be imported, 1 events were skipped
+			`),
+		},
+		{
+			name:  "stdin as ics",
+			args:  []string{"-", "--from", "ics"},
+			stdin: ics,
+			expected: heredoc.Doc(`
+				+ 2026-12-25 Christmas
+				1 days off were imported, 1 events were skipped
+			`),
+			saved: []worktime.TimeOff{
+				{Date: "2026-12-25", Name: "Christmas"},
+			},
+		},
+		{
+			name: "yaml as half days",
+			args: []string{write("carnival.yml", yaml), "--half"},
+			expected: heredoc.Doc(`
+				+ 2026-02-16 Carnival
+				+ 2026-02-17 Carnival
+				2 days off were imported
+			`),
+			saved: []worktime.TimeOff{
+				{Date: "2026-02-16", Name: "Carnival", Half: true},
+				{Date: "2026-02-17", Name: "Carnival", Half: true},
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := worktime.NewCalendar(
+				filepath.Join(t.TempDir(), "time-off.yaml"))
+			f := mocks.NewMockFactory(t)
+			if tt.saved != nil {
+				f.On("TimeOff").Return(cal, nil)
+			}
+
+			out := &bytes.Buffer{}
+			cmd := imp.NewCmdImport(f)
+			cmd.SetArgs(tt.args)
+			cmd.SetIn(strings.NewReader(tt.stdin))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(out)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+
+			if tt.saved == nil {
+				return
+			}
+
+			ts, err := cal.All()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.saved, ts)
+		})
+	}
+}
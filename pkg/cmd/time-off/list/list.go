@@ -0,0 +1,122 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(f cmdutil.Factory) *cobra.Command {
+	var (
+		asJSON bool
+		quiet  bool
+		year   int
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the holidays and days off",
+		Example: heredoc.Doc(`
+			$ clockify-cli time-off list --year 2026
+			+------------+----------+---------------+-------+
+			|    DATE    | WEEKDAY  |     NAME      | HOURS |
+			+------------+----------+---------------+-------+
+			| 2026-12-24 | Thursday | Christmas Eve | half  |
+			| 2026-12-25 | Friday   | Christmas     |       |
+			+------------+----------+---------------+-------+
+
+			$ clockify-cli time-off list --quiet
+			2026-12-24
+			2026-12-25
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"json":  asJSON,
+				"quiet": quiet,
+			}); err != nil {
+				return err
+			}
+
+			cal, err := f.TimeOff()
+			if err != nil {
+				return err
+			}
+
+			ts, err := cal.All()
+			if err != nil {
+				return err
+			}
+
+			if year != 0 {
+				ts = filterYear(ts, year)
+			}
+
+			out := cmd.OutOrStdout()
+			switch {
+			case asJSON:
+				return json.NewEncoder(out).Encode(ts)
+			case quiet:
+				for i := range ts {
+					if _, err := fmt.Fprintln(out, ts[i].Date); err != nil {
+						return err
+					}
+				}
+				return nil
+			default:
+				report(out, ts)
+				return nil
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "only display dates")
+	cmd.Flags().IntVarP(&year, "year", "y", 0,
+		"only list the days of this year")
+
+	return cmd
+}
+
+func filterYear(ts []worktime.TimeOff, year int) []worktime.TimeOff {
+	prefix := fmt.Sprintf("%04d-", year)
+	r := make([]worktime.TimeOff, 0, len(ts))
+	for _, t := range ts {
+		if strings.HasPrefix(t.Date, prefix) {
+			r = append(r, t)
+		}
+	}
+
+	return r
+}
+
+func report(out io.Writer, ts []worktime.TimeOff) {
+	tw := tablewriter.NewWriter(out)
+	tw.SetHeader([]string{"Date", "Weekday", "Name", "Hours"})
+
+	for _, t := range ts {
+		w := ""
+		if d, err := time.Parse(worktime.DateFormat, t.Date); err == nil {
+			w = d.Weekday().String()
+		}
+
+		h := t.Hours
+		if t.Half {
+			h = "half"
+		}
+
+		tw.Append([]string{t.Date, w, t.Name, h})
+	}
+
+	tw.Render()
+}
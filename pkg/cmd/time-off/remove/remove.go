@@ -0,0 +1,57 @@
+package remove
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemove represents the remove command
+func NewCmdRemove(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <date>...",
+		Aliases: []string{"rm", "delete", "del"},
+		Args:    cmdutil.RequiredNamedArgs("date"),
+		ValidArgsFunction: func(
+			_ *cobra.Command, _ []string, _ string,
+		) ([]string, cobra.ShellCompDirective) {
+			return validArgs(f), cobra.ShellCompDirectiveNoFileComp
+		},
+		Short: "Removes holidays and days off",
+		Example: heredoc.Doc(`
+			$ clockify-cli time-off remove 2026-12-24 2026-12-25
+		`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cal, err := f.TimeOff()
+			if err != nil {
+				return err
+			}
+
+			return cal.Remove(args...)
+		},
+	}
+
+	return cmd
+}
+
+func validArgs(f cmdutil.Factory) []string {
+	cal, err := f.TimeOff()
+	if err != nil {
+		return nil
+	}
+
+	ts, err := cal.All()
+	if err != nil {
+		return nil
+	}
+
+	ds := make([]string, len(ts))
+	for i := range ts {
+		ds[i] = ts[i].Date
+		if ts[i].Name != "" {
+			ds[i] += "\t" + ts[i].Name
+		}
+	}
+
+	return ds
+}
@@ -0,0 +1,31 @@
+package timeoff
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/add"
+	imp "github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/import"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTimeOff represents the time-off command
+func NewCmdTimeOff(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "time-off",
+		Aliases: []string{"holidays", "days-off"},
+		Short:   "Work with the holidays and days off",
+		Long: "Work with the holidays and days off, which are saved on the " +
+			"file ~/.config/clockify-cli/time-off.yaml and skipped (or " +
+			"expected to be worked partially) by reports, " +
+			"\"fill\" and \"balance\"",
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f))
+	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(remove.NewCmdRemove(f))
+	cmd.AddCommand(imp.NewCmdImport(f))
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cmdutil
 import (
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +35,18 @@ const (
 	CONF_LANGUAGE                         = "lang"
 	CONF_TIMEZONE                         = "time-zone"
 	CONF_API_URL                          = "api-url"
+	CONF_CACHE_TTL                        = "cache-ttl"
+	CONF_OFFLINE_JOURNAL                  = "offline-journal"
+	CONF_RETRY_ATTEMPTS                   = "retry-attempts"
+	CONF_RETRY_MAX_DELAY                  = "retry-max-delay"
+	CONF_TIME_ENTRY_TEMPLATES             = "time-entry-templates"
+	CONF_DAILY_HOURS                      = "daily-hours"
+	CONF_POMODORO_BREAK_PROJECT           = "pomodoro-break-project"
+	CONF_POMODORO_NOTIFY                  = "pomodoro-notify"
+	CONF_IDLE_THRESHOLD                   = "idle-threshold"
+	CONF_WORKDAY_END                      = "workday-end"
+	CONF_BALANCE_START                    = "balance-start"
+	CONF_STATUS_TTL                       = "status-ttl"
 )
 
 const (
@@ -99,6 +112,39 @@ type Config interface {
 	// LogLevel sets how much should be logged during execution
 	LogLevel() string
 
+	// CacheTTL is for how long workspace metadata (projects, tasks, tags,
+	// clients and users) can be reused from the local cache, zero (the
+	// default) disables it
+	CacheTTL() time.Duration
+	// StatusTTL is for how long the time entry running can be reused from
+	// the local state by the "status" command, zero disables it
+	StatusTTL() time.Duration
+
+	// RetryAttempts is how many times a request that failed by a transient
+	// error is retried, zero (the default) disables it
+	RetryAttempts() int
+	// RetryMaxDelay is the longest wait between retries
+	RetryMaxDelay() time.Duration
+
+	// DailyHours is how long the user is expected to work on each of the
+	// workweek days
+	DailyHours() time.Duration
+	// DailyHoursOn is how long the user is expected to work on the weekday,
+	// zero for days out of the workweek, unless set for the weekday
+	DailyHoursOn(time.Weekday) time.Duration
+
+	// IdleThreshold is how long a time entry can run before being considered
+	// forgotten, zero disables it
+	IdleThreshold() time.Duration
+	// WorkdayEnd is the time of the day the user stops working, zero when
+	// not set
+	WorkdayEnd() time.Duration
+
+	// GetTimeEntryTemplates retrieves the time entry templates saved
+	GetTimeEntryTemplates() ([]TimeEntryTemplate, error)
+	// SetTimeEntryTemplates changes the time entry templates saved
+	SetTimeEntryTemplates([]TimeEntryTemplate)
+
 	// Save will persist the changes made to the configuration
 	Save() error
 }
@@ -135,6 +181,145 @@ func (c *config) LogLevel() string {
 	}
 }
 
+func (c *config) CacheTTL() time.Duration {
+	v := strings.TrimSpace(c.GetString(CONF_CACHE_TTL))
+	if v == "" || v == "0" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+// DEFAULT_STATUS_TTL is used when the status TTL is not set by the user
+const DEFAULT_STATUS_TTL = 5 * time.Minute
+
+func (c *config) StatusTTL() time.Duration {
+	v := strings.TrimSpace(c.GetString(CONF_STATUS_TTL))
+	if v == "" {
+		return DEFAULT_STATUS_TTL
+	}
+
+	if v == "0" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return DEFAULT_STATUS_TTL
+	}
+
+	return d
+}
+
+// DEFAULT_RETRY_MAX_DELAY is used when the retry max delay is not set by the
+// user
+const DEFAULT_RETRY_MAX_DELAY = 30 * time.Second
+
+func (c *config) RetryAttempts() int {
+	v := strings.TrimSpace(c.GetString(CONF_RETRY_ATTEMPTS))
+	if v == "" {
+		return 0
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		return 0
+	}
+
+	return i
+}
+
+func (c *config) RetryMaxDelay() time.Duration {
+	v := strings.TrimSpace(c.GetString(CONF_RETRY_MAX_DELAY))
+	if v == "" {
+		return DEFAULT_RETRY_MAX_DELAY
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return DEFAULT_RETRY_MAX_DELAY
+	}
+
+	return d
+}
+
+// DEFAULT_DAILY_HOURS is used when the daily hours are not set by the user
+const DEFAULT_DAILY_HOURS = 8 * time.Hour
+
+func (c *config) DailyHours() time.Duration {
+	d, _ := parseDailyHours(c.GetString(CONF_DAILY_HOURS))
+	return d
+}
+
+func (c *config) DailyHoursOn(wd time.Weekday) time.Duration {
+	d, ws := parseDailyHours(c.GetString(CONF_DAILY_HOURS))
+
+	n := strings.ToLower(wd.String())
+	if h, ok := ws[n]; ok {
+		return h
+	}
+
+	if !strhlp.InSlice(n, c.GetWorkWeekdays()) {
+		return 0
+	}
+
+	return d
+}
+
+// parseDailyHours reads values like "8h" or "8h,friday=4h", returning the
+// hours of the workweek days and the ones set for specific weekdays. Invalid
+// parts are ignored
+func parseDailyHours(v string) (time.Duration, map[string]time.Duration) {
+	d := DEFAULT_DAILY_HOURS
+	ws := map[string]time.Duration{}
+	for _, p := range strings.Split(v, ",") {
+		wd, h, ok := strings.Cut(strings.TrimSpace(p), "=")
+		if !ok {
+			wd, h = "", wd
+		}
+
+		hd, err := time.ParseDuration(strings.TrimSpace(h))
+		if err != nil || hd < 0 {
+			continue
+		}
+
+		if wd == "" {
+			d = hd
+			continue
+		}
+
+		ws[strings.ToLower(strings.TrimSpace(wd))] = hd
+	}
+
+	return d, ws
+}
+
+func (c *config) IdleThreshold() time.Duration {
+	d, err := time.ParseDuration(strings.TrimSpace(
+		c.GetString(CONF_IDLE_THRESHOLD)))
+	if err != nil || d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+func (c *config) WorkdayEnd() time.Duration {
+	t, err := time.Parse("15:04", strings.TrimSpace(
+		c.GetString(CONF_WORKDAY_END)))
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute
+}
+
 func (*config) GetBool(param string) bool {
 	return viper.GetBool(param)
 }
@@ -167,6 +352,16 @@ func (*config) SetStringSlice(p string, ss []string) {
 	viper.Set(p, ss)
 }
 
+func (*config) GetTimeEntryTemplates() ([]TimeEntryTemplate, error) {
+	var ts []TimeEntryTemplate
+	err := viper.UnmarshalKey(CONF_TIME_ENTRY_TEMPLATES, &ts)
+	return ts, err
+}
+
+func (*config) SetTimeEntryTemplates(ts []TimeEntryTemplate) {
+	viper.Set(CONF_TIME_ENTRY_TEMPLATES, ts)
+}
+
 func (c *config) IsDebuging() bool {
 	return c.LogLevel() == LOG_LEVEL_DEBUG
 }
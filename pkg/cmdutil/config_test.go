@@ -0,0 +1,106 @@
+package cmdutil_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_DailyHoursOn(t *testing.T) {
+	c := cmdutil.NewFactory(context.Background(), cmdutil.Version{}).Config()
+	t.Cleanup(func() {
+		viper.Set(cmdutil.CONF_DAILY_HOURS, nil)
+		viper.Set(cmdutil.CONF_WORKWEEK_DAYS, nil)
+	})
+
+	viper.Set(cmdutil.CONF_WORKWEEK_DAYS, []string{"monday", "friday"})
+
+	tts := []struct {
+		value    string
+		daily    time.Duration
+		expected map[time.Weekday]time.Duration
+	}{
+		{
+			value: "",
+			daily: cmdutil.DEFAULT_DAILY_HOURS,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday: 8 * time.Hour, time.Friday: 8 * time.Hour},
+		},
+		{
+			value: "6h",
+			daily: 6 * time.Hour,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday: 6 * time.Hour, time.Friday: 6 * time.Hour},
+		},
+		{
+			value: "7h30m, Friday=4h,saturday=2h,sunday=x",
+			daily: 7*time.Hour + 30*time.Minute,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday:   7*time.Hour + 30*time.Minute,
+				time.Friday:   4 * time.Hour,
+				time.Saturday: 2 * time.Hour,
+			},
+		},
+		{
+			value: "friday=0",
+			daily: cmdutil.DEFAULT_DAILY_HOURS,
+			expected: map[time.Weekday]time.Duration{
+				time.Monday: 8 * time.Hour},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.value, func(t *testing.T) {
+			viper.Set(cmdutil.CONF_DAILY_HOURS, tt.value)
+
+			assert.Equal(t, tt.daily, c.DailyHours())
+			for wd := time.Sunday; wd <= time.Saturday; wd++ {
+				assert.Equal(t, tt.expected[wd], c.DailyHoursOn(wd),
+					wd.String())
+			}
+		})
+	}
+}
+
+func TestConfig_CacheTTL(t *testing.T) {
+	c := cmdutil.NewFactory(context.Background(), cmdutil.Version{}).Config()
+	t.Cleanup(func() { viper.Set(cmdutil.CONF_CACHE_TTL, nil) })
+
+	tts := map[string]time.Duration{
+		"":    0,
+		"0":   0,
+		"x":   0,
+		"-1h": 0,
+		"30m": 30 * time.Minute,
+	}
+
+	for value, expected := range tts {
+		t.Run(value, func(t *testing.T) {
+			viper.Set(cmdutil.CONF_CACHE_TTL, value)
+			assert.Equal(t, expected, c.CacheTTL())
+		})
+	}
+}
+
+func TestConfig_RetryAttempts(t *testing.T) {
+	c := cmdutil.NewFactory(context.Background(), cmdutil.Version{}).Config()
+	t.Cleanup(func() { viper.Set(cmdutil.CONF_RETRY_ATTEMPTS, nil) })
+
+	tts := map[string]int{
+		"":   0,
+		"x":  0,
+		"-1": 0,
+		"5":  5,
+	}
+
+	for value, expected := range tts {
+		t.Run(value, func(t *testing.T) {
+			viper.Set(cmdutil.CONF_RETRY_ATTEMPTS, value)
+			assert.Equal(t, expected, c.RetryAttempts())
+		})
+	}
+}
@@ -1,18 +1,31 @@
 package cmdutil
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
 	"os"
+	"path"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/lucassabreu/clockify-cli/pkg/running"
 	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/mitchellh/go-homedir"
 )
 
 // Factory is a container/factory builder for the commands and its helpers
 type Factory interface {
 	// Version of the CLI
 	Version() Version
+	// Context is used by the clients built by the factory, it is cancelled
+	// when the user interrupts the CLI
+	Context() context.Context
 
 	// Config returns configurations set by the user
 	Config() Config
@@ -20,6 +33,17 @@ type Factory interface {
 	Client() (api.Client, error)
 	// UI builds a control to prompt information from the user
 	UI() ui.UI
+	// Cache returns the local store of workspace metadata
+	Cache() (*cache.Store, error)
+	// Journal returns the local journal of changes to time entries made while
+	// offline
+	Journal() (*journal.Journal, error)
+	// ImportLedger returns the local record of the time entries imported
+	ImportLedger() (*importer.Ledger, error)
+	// TimeOff returns the local calendar of the days off of the user
+	TimeOff() (*worktime.Calendar, error)
+	// Running returns the local state of the time entry running
+	Running() (*running.Store, error)
 
 	// GetUserID returns the current user id
 	GetUserID() (string, error)
@@ -31,10 +55,16 @@ type Factory interface {
 
 type factory struct {
 	version func() Version
+	ctx     context.Context
 
-	config func() Config
-	client func() (api.Client, error)
-	ui     func() ui.UI
+	config  func() Config
+	client  func() (api.Client, error)
+	ui      func() ui.UI
+	cache   func() (*cache.Store, error)
+	journal func() (*journal.Journal, error)
+	ledger  func() (*importer.Ledger, error)
+	timeOff func() (*worktime.Calendar, error)
+	running func() (*running.Store, error)
 
 	getUserID      func() (string, error)
 	getWorkspaceID func() (string, error)
@@ -45,6 +75,10 @@ func (f *factory) Version() Version {
 	return f.version()
 }
 
+func (f *factory) Context() context.Context {
+	return f.ctx
+}
+
 func (f *factory) Config() Config {
 	return f.config()
 }
@@ -57,6 +91,26 @@ func (f *factory) UI() ui.UI {
 	return f.ui()
 }
 
+func (f *factory) Cache() (*cache.Store, error) {
+	return f.cache()
+}
+
+func (f *factory) Journal() (*journal.Journal, error) {
+	return f.journal()
+}
+
+func (f *factory) ImportLedger() (*importer.Ledger, error) {
+	return f.ledger()
+}
+
+func (f *factory) TimeOff() (*worktime.Calendar, error) {
+	return f.timeOff()
+}
+
+func (f *factory) Running() (*running.Store, error) {
+	return f.running()
+}
+
 func (f *factory) GetUserID() (string, error) {
 	return f.getUserID()
 }
@@ -69,14 +123,22 @@ func (f *factory) GetWorkspace() (dto.Workspace, error) {
 	return f.getWorkspace()
 }
 
-func NewFactory(v Version) Factory {
+// NewFactory creates a Factory whose clients will send their requests using
+// ctx
+func NewFactory(ctx context.Context, v Version) Factory {
 	f := &factory{
+		ctx:     ctx,
 		version: func() Version { return v },
 		config:  configFunc(),
 	}
 
 	f.ui = getUi(f)
 
+	f.cache = cacheFunc(f)
+	f.journal = journalFunc()
+	f.ledger = ledgerFunc()
+	f.timeOff = timeOffFunc()
+	f.running = runningFunc(f)
 	f.client = clientFunc(f)
 
 	f.getUserID = getUserIDFunc(f)
@@ -194,6 +256,37 @@ func clientFunc(f Factory) func() (api.Client, error) {
 			return c, err
 		}
 
+		c = c.WithContext(f.Context())
+		c.SetRetryPolicy(api.RetryPolicy{
+			Retries:  f.Config().RetryAttempts(),
+			MaxDelay: f.Config().RetryMaxDelay(),
+		})
+
+		if f.Config().CacheTTL() > 0 {
+			s, err := f.Cache()
+			if err != nil {
+				return c, err
+			}
+
+			c = cache.NewClient(c, s)
+		}
+
+		if f.Config().GetBool(CONF_OFFLINE_JOURNAL) {
+			j, err := f.Journal()
+			if err != nil {
+				return c, err
+			}
+
+			c = journal.NewClient(c, j, os.Stderr)
+		}
+
+		// the time entry running is only kept after "status" is used, and
+		// the client works without it if its store can't be used
+		if r, rErr := f.Running(); rErr == nil &&
+			(r.InUse() || f.Config().GetString(CONF_STATUS_TTL) != "") {
+			c = running.NewClient(c, r)
+		}
+
 		ll := f.Config().LogLevel()
 		if ll == LOG_LEVEL_NONE {
 			return c, err
@@ -215,6 +308,113 @@ func clientFunc(f Factory) func() (api.Client, error) {
 	}
 }
 
+func cacheFunc(f Factory) func() (*cache.Store, error) {
+	var s *cache.Store
+	var err error
+
+	return func() (*cache.Store, error) {
+		if s != nil || err != nil {
+			return s, err
+		}
+
+		var home string
+		if home, err = homedir.Dir(); err != nil {
+			return s, err
+		}
+
+		// each API key has its own cache, as the metadata visible to
+		// each user is different
+		k := sha256.Sum256([]byte(f.Config().GetString(CONF_TOKEN)))
+		s = cache.NewStore(
+			path.Join(home, ".config", "clockify-cli", "cache",
+				hex.EncodeToString(k[:8])),
+			f.Config().CacheTTL(),
+		)
+		return s, err
+	}
+}
+
+func journalFunc() func() (*journal.Journal, error) {
+	var j *journal.Journal
+	var err error
+
+	return func() (*journal.Journal, error) {
+		if j != nil || err != nil {
+			return j, err
+		}
+
+		var home string
+		if home, err = homedir.Dir(); err != nil {
+			return j, err
+		}
+
+		j = journal.New(path.Join(home, ".config", "clockify-cli", "journal"))
+		return j, err
+	}
+}
+
+func ledgerFunc() func() (*importer.Ledger, error) {
+	var l *importer.Ledger
+	var err error
+
+	return func() (*importer.Ledger, error) {
+		if l != nil || err != nil {
+			return l, err
+		}
+
+		var home string
+		if home, err = homedir.Dir(); err != nil {
+			return l, err
+		}
+
+		l = importer.NewLedger(
+			path.Join(home, ".config", "clockify-cli", "import-ledger"))
+		return l, err
+	}
+}
+
+func timeOffFunc() func() (*worktime.Calendar, error) {
+	var c *worktime.Calendar
+	var err error
+
+	return func() (*worktime.Calendar, error) {
+		if c != nil || err != nil {
+			return c, err
+		}
+
+		var home string
+		if home, err = homedir.Dir(); err != nil {
+			return c, err
+		}
+
+		c = worktime.NewCalendar(
+			path.Join(home, ".config", "clockify-cli", "time-off.yaml"))
+		return c, err
+	}
+}
+
+func runningFunc(f Factory) func() (*running.Store, error) {
+	var s *running.Store
+	var err error
+
+	return func() (*running.Store, error) {
+		if s != nil || err != nil {
+			return s, err
+		}
+
+		var home string
+		if home, err = homedir.Dir(); err != nil {
+			return s, err
+		}
+
+		s = running.NewStore(
+			path.Join(home, ".config", "clockify-cli", "running.json"),
+			f.Config().StatusTTL(),
+		)
+		return s, err
+	}
+}
+
 func getUi(f Factory) func() ui.UI {
 	var i ui.UI
 	return func() ui.UI {
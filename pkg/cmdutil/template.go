@@ -0,0 +1,119 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+)
+
+// TimeEntryTemplate is a time entry saved on the config, used to create the
+// same time entry on many days (like stand-ups and 1:1s)
+type TimeEntryTemplate struct {
+	Name        string   `mapstructure:"name" yaml:"name" json:"name"`
+	Description string   `mapstructure:"description" yaml:"description,omitempty" json:"description,omitempty"`
+	Project     string   `mapstructure:"project" yaml:"project,omitempty" json:"project,omitempty"`
+	Client      string   `mapstructure:"client" yaml:"client,omitempty" json:"client,omitempty"`
+	Task        string   `mapstructure:"task" yaml:"task,omitempty" json:"task,omitempty"`
+	Tags        []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+	Billable    *bool    `mapstructure:"billable" yaml:"billable,omitempty" json:"billable,omitempty"`
+	// CustomFields values by the custom field's ID
+	CustomFields map[string]string `mapstructure:"custom-fields" yaml:"custom-fields,omitempty" json:"customFields,omitempty"`
+	// Start is the time of the day the time entries start, like "09:30"
+	Start string `mapstructure:"start" yaml:"start" json:"start"`
+	// Duration of the time entries, like "15m" or "1h30m"
+	Duration string `mapstructure:"duration" yaml:"duration" json:"duration"`
+	// Weekdays when the time entries should be created, if empty the
+	// workweek days are used
+	Weekdays []string `mapstructure:"weekdays" yaml:"weekdays,omitempty" json:"weekdays,omitempty"`
+}
+
+const templateStartFormat = "15:04"
+
+// Validate checks if the template can be used to create time entries
+func (t TimeEntryTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.New("template name should not be empty")
+	}
+
+	if _, err := time.Parse(templateStartFormat, t.Start); err != nil {
+		return fmt.Errorf(
+			"start \"%s\" is invalid, use a time like \"09:30\"", t.Start)
+	}
+
+	if d, err := time.ParseDuration(t.Duration); err != nil || d <= 0 {
+		return fmt.Errorf(
+			"duration \"%s\" is invalid, use a duration like \"1h30m\"",
+			t.Duration)
+	}
+
+	ws := GetWeekdays()
+	for _, w := range t.Weekdays {
+		if strhlp.Search(strings.ToLower(w), ws) == -1 {
+			return fmt.Errorf(
+				"weekday \"%s\" is invalid, use one of: %s",
+				w, strings.Join(ws, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Interval returns when the time entry of the template starts and ends on
+// the day informed
+func (t TimeEntryTemplate) Interval(day time.Time) (
+	start, end time.Time, err error) {
+	s, err := time.Parse(templateStartFormat, t.Start)
+	if err != nil {
+		return start, end, err
+	}
+
+	d, err := time.ParseDuration(t.Duration)
+	if err != nil {
+		return start, end, err
+	}
+
+	start = time.Date(day.Year(), day.Month(), day.Day(),
+		s.Hour(), s.Minute(), 0, 0, day.Location())
+	return start, start.Add(d), nil
+}
+
+// RunsOn returns if the template should be used on the day informed, using
+// workweek as the weekdays when the template has none
+func (t TimeEntryTemplate) RunsOn(day time.Time, workweek []string) bool {
+	ws := t.Weekdays
+	if len(ws) == 0 {
+		ws = workweek
+	}
+
+	ws = strhlp.Map(strings.ToLower, ws)
+	return strhlp.Search(strings.ToLower(day.Weekday().String()), ws) != -1
+}
+
+// CustomFieldValues returns the custom fields of the template, sorted by ID,
+// as they are sent to create a time entry
+func (t TimeEntryTemplate) CustomFieldValues() []dto.CustomFieldValue {
+	if len(t.CustomFields) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(t.CustomFields))
+	for id := range t.CustomFields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	cfs := make([]dto.CustomFieldValue, len(ids))
+	for i, id := range ids {
+		cfs[i] = dto.CustomFieldValue{
+			CustomFieldID: id,
+			Value:         t.CustomFields[id],
+		}
+	}
+
+	return cfs
+}
@@ -0,0 +1,72 @@
+package cmdutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntryTemplate_Validate(t *testing.T) {
+	tpl := cmdutil.TimeEntryTemplate{
+		Name:     "Stand-up",
+		Start:    "09:30",
+		Duration: "15m",
+		Weekdays: []string{"Monday"},
+	}
+	assert.NoError(t, tpl.Validate())
+
+	tts := map[string]func(cmdutil.TimeEntryTemplate) cmdutil.TimeEntryTemplate{
+		"template name should not be empty": func(
+			t cmdutil.TimeEntryTemplate) cmdutil.TimeEntryTemplate {
+			t.Name = " "
+			return t
+		},
+		`start "9h" is invalid, use a time like "09:30"`: func(
+			t cmdutil.TimeEntryTemplate) cmdutil.TimeEntryTemplate {
+			t.Start = "9h"
+			return t
+		},
+		`duration "-1h" is invalid, use a duration like "1h30m"`: func(
+			t cmdutil.TimeEntryTemplate) cmdutil.TimeEntryTemplate {
+			t.Duration = "-1h"
+			return t
+		},
+		`weekday "mon" is invalid, use one of: sunday, monday, tuesday, ` +
+			`wednesday, thursday, friday, saturday`: func(
+			t cmdutil.TimeEntryTemplate) cmdutil.TimeEntryTemplate {
+			t.Weekdays = []string{"mon"}
+			return t
+		},
+	}
+
+	for msg, fn := range tts {
+		t.Run(msg, func(t *testing.T) {
+			assert.EqualError(t, fn(tpl).Validate(), msg)
+		})
+	}
+}
+
+func TestTimeEntryTemplate_Interval(t *testing.T) {
+	tpl := cmdutil.TimeEntryTemplate{Start: "14:00", Duration: "1h30m"}
+	day := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	s, e, err := tpl.Interval(day)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 15, 14, 0, 0, 0, time.UTC), s)
+	assert.Equal(t, time.Date(2026, 10, 15, 15, 30, 0, 0, time.UTC), e)
+}
+
+func TestTimeEntryTemplate_RunsOn(t *testing.T) {
+	thursday := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	workweek := []string{"monday", "thursday"}
+
+	assert.True(t, cmdutil.TimeEntryTemplate{}.RunsOn(thursday, workweek))
+	assert.False(t, cmdutil.TimeEntryTemplate{}.RunsOn(
+		thursday.AddDate(0, 0, 1), workweek))
+	assert.True(t, cmdutil.TimeEntryTemplate{Weekdays: []string{"Thursday"}}.
+		RunsOn(thursday, nil))
+	assert.False(t, cmdutil.TimeEntryTemplate{Weekdays: []string{"monday"}}.
+		RunsOn(thursday, workweek))
+}
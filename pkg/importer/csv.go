@@ -0,0 +1,169 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tagRef matches the tags as printed by the CLI: "Name (ID)"
+var tagRef = regexp.MustCompile(`^.*\(([^()]+)\)$`)
+
+// ParseCSV reads time entries from a CSV with a header line, like the ones
+// printed with --csv. The columns recognized are: description, project.id,
+// project.name (or project), client.name (or client), task.id, task.name (or
+// task), start, end, duration, billable and tags... (or tags), all other
+// columns are ignored
+func ParseCSV(r io.Reader) ([]Entry, error) {
+	return readCSV(r, FormatCSV, []string{"start"}, func(
+		e *Entry, c csvRow) error {
+		e.Description = c.get("description")
+		e.Project = c.get("project.id", "project.name", "project")
+		e.Client = c.get("client.name", "client")
+		e.Task = c.get("task.id", "task.name", "task")
+		e.Tags = splitTags(c.get("tags...", "tags"))
+
+		if err := c.billable(e, "billable"); err != nil {
+			return err
+		}
+
+		return e.setInterval(c.get("start"), c.get("end"), c.get("duration"))
+	})
+}
+
+// csvRow gives access to the columns of a row by their names on the header
+type csvRow struct {
+	row  []string
+	cols map[string]int
+}
+
+// get returns the value of the first column that is filled
+func (c csvRow) get(names ...string) string {
+	for _, n := range names {
+		if i, ok := c.cols[n]; ok && i < len(c.row) &&
+			strings.TrimSpace(c.row[i]) != "" {
+			return strings.TrimSpace(c.row[i])
+		}
+	}
+
+	return ""
+}
+
+// billable sets the billable flag of the entry if the column is filled
+func (c csvRow) billable(e *Entry, name string) error {
+	b := c.get(name)
+	if b == "" {
+		return nil
+	}
+
+	v, err := parseBool(b)
+	if err != nil {
+		return err
+	}
+
+	e.Billable = &v
+	return nil
+}
+
+// readCSV reads a CSV with a header line, which must have the required
+// columns (compared in lower case), calling fn for each row
+func readCSV(
+	r io.Reader,
+	f Format,
+	required []string,
+	fn func(*Entry, csvRow) error,
+) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %w", err)
+	}
+
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimPrefix(h, "\ufeff")
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	for _, c := range required {
+		if _, ok := cols[c]; !ok {
+			return nil, errors.New("csv must have a \"" + c + "\" column")
+		}
+	}
+
+	es := make([]Entry, 0)
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			es = append(es, Entry{Line: pe.StartLine, Err: pe.Err})
+			continue
+		}
+
+		if err != nil {
+			return es, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		e := Entry{Line: line, Key: entryKey(f, row...)}
+		e.Err = fn(&e, csvRow{row: row, cols: cols})
+		es = append(es, e)
+	}
+
+	return es, nil
+}
+
+// splitTags reads tags separated by ";", using the ID when they have one
+func splitTags(s string) []string {
+	tags := splitList(s, ";")
+	for i := range tags {
+		if m := tagRef.FindStringSubmatch(tags[i]); m != nil {
+			tags[i] = m[1]
+		}
+	}
+
+	return tags
+}
+
+// splitList reads values separated by sep, ignoring the empty ones
+func splitList(s, sep string) []string {
+	if s == "" {
+		return nil
+	}
+
+	l := make([]string, 0)
+	for _, v := range strings.Split(s, sep) {
+		if v = strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return b, fmt.Errorf("billable \"%s\" is invalid", s)
+	}
+
+	return b, nil
+}
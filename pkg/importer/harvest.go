@@ -0,0 +1,56 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// FormatHarvest is the detailed time report CSV export of Harvest
+const FormatHarvest Format = "harvest"
+
+// harvestDayStart is when the first entry of a day starts, as Harvest only
+// exports the hours spent on each day
+const harvestDayStart = 9 * time.Hour
+
+// ParseHarvest reads time entries from a detailed time report CSV export of
+// Harvest, using the columns: Date, Client, Project, Task, Notes, Hours and
+// Billable?. Harvest does not export when the entries started, so the entries
+// of each day are placed one after the other, starting at 09:00
+func ParseHarvest(r io.Reader) ([]Entry, error) {
+	next := map[string]time.Time{}
+	return readCSV(r, FormatHarvest, []string{"date", "hours"},
+		func(e *Entry, c csvRow) error {
+			e.Description = c.get("notes")
+			e.Project = c.get("project")
+			e.Client = c.get("client")
+			e.Task = c.get("task")
+
+			if err := c.billable(e, "billable?"); err != nil {
+				return err
+			}
+
+			day := c.get("date")
+			start, ok := next[day]
+			if !ok {
+				d, err := time.ParseInLocation("2006-01-02", day, time.Local)
+				if err != nil {
+					return fmt.Errorf("date \"%s\" is invalid", day)
+				}
+				start = d.Add(harvestDayStart)
+			}
+
+			h, err := strconv.ParseFloat(c.get("hours"), 64)
+			if err != nil || h < 0 {
+				return fmt.Errorf("hours \"%s\" is invalid", c.get("hours"))
+			}
+
+			e.Start = start
+			e.End = start.Add(
+				time.Duration(h * float64(time.Hour)).Round(time.Second))
+			next[day] = e.End
+
+			return nil
+		})
+}
@@ -0,0 +1,187 @@
+// importer package reads time entries from files exported by the CLI or by
+// other time trackers, so they can be created on Clockify
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// Entry is a time entry read from a file. Project, Task and Tags keep the IDs
+// or names as they were found, and must be resolved before creating it
+type Entry struct {
+	// Line (or position) of the time entry on the file
+	Line int
+	// Key identifies the entry, it is the same every time the same file is
+	// read and is used to avoid importing it twice
+	Key string
+
+	Description string
+	Project     string
+	Client      string
+	Task        string
+	Tags        []string
+	Billable    *bool
+	Start       time.Time
+	End         time.Time
+
+	// Err is set when the time entry could not be read
+	Err error
+}
+
+// Format is a type of file that can be imported
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// entryKey builds a key for a entry using the format and the values read
+// from the file
+func entryKey(f Format, values ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(values, "\x1f")))
+	return string(f) + ":" + hex.EncodeToString(h[:16])
+}
+
+// Parser reads all time entries of a file. Invalid time entries must be
+// returned with Err set, failing only when the file can't be read at all
+type Parser func(io.Reader) ([]Entry, error)
+
+var parsers = map[Format]Parser{
+	FormatCSV:         ParseCSV,
+	FormatJSON:        ParseJSON,
+	FormatToggl:       ParseToggl,
+	FormatHarvest:     ParseHarvest,
+	FormatTimewarrior: ParseTimewarrior,
+}
+
+// Formats returns the formats that can be imported
+func Formats() []string {
+	fs := make([]string, 0, len(parsers))
+	for f := range parsers {
+		fs = append(fs, string(f))
+	}
+
+	sort.Strings(fs)
+	return fs
+}
+
+// Parse reads the time entries of r using the parser of the format
+func Parse(f Format, r io.Reader) ([]Entry, error) {
+	p, ok := parsers[f]
+	if !ok {
+		return nil, fmt.Errorf(
+			"format \"%s\" is not supported, use one of: %s",
+			f, strings.Join(Formats(), ", "))
+	}
+
+	return p(r)
+}
+
+// FormatFromFilename returns the format of a file based on its extension
+func FormatFromFilename(name string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimPrefix(filepath.Ext(name), ".")))
+	if f != FormatCSV && f != FormatJSON {
+		return f, errors.New(
+			"can't detect the format of \"" + name + "\", set it with --from")
+	}
+
+	return f, nil
+}
+
+var timeFormats = []string{
+	timehlp.FullTimeFormat,
+	timehlp.SimplerTimeFormat,
+	"2006-01-02T15:04:05",
+}
+
+// parseTime reads a time as RFC 3339, or as a local time on the formats
+// used by the CLI
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	for _, f := range timeFormats {
+		if t, err := time.ParseInLocation(f, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf(
+		"time \"%s\" is invalid, use RFC 3339 or \"%s\"",
+		s, timehlp.FullTimeFormat)
+}
+
+// parseDuration reads a duration as "1:30:00", "1:30", "1h30m" or "PT1H30M"
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "PT") {
+		return dto.StringToDuration(s)
+	}
+
+	if !strings.Contains(s, ":") {
+		return time.ParseDuration(s)
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("duration \"%s\" is invalid", s)
+	}
+
+	var d time.Duration
+	u := time.Hour
+	for _, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("duration \"%s\" is invalid", s)
+		}
+
+		d += time.Duration(v) * u
+		u /= 60
+	}
+
+	return d, nil
+}
+
+// setInterval fills the interval of the entry, using the duration when the
+// end is not informed
+func (e *Entry) setInterval(start, end, duration string) error {
+	var err error
+	if e.Start, err = parseTime(start); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(end) != "" {
+		if e.End, err = parseTime(end); err != nil {
+			return err
+		}
+	} else if strings.TrimSpace(duration) != "" {
+		d, err := parseDuration(duration)
+		if err != nil {
+			return err
+		}
+		e.End = e.Start.Add(d)
+	} else {
+		return errors.New("end or duration is required")
+	}
+
+	if e.End.Before(e.Start) {
+		return errors.New("end is before start")
+	}
+
+	return nil
+}
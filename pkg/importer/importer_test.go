@@ -0,0 +1,184 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+func local(s string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
+	return t
+}
+
+// withoutKeys clears the keys of the entries, to compare the other fields
+func withoutKeys(es []importer.Entry) []importer.Entry {
+	for i := range es {
+		es[i].Key = ""
+	}
+	return es
+}
+
+func TestParseCSV(t *testing.T) {
+	es, err := importer.Parse(importer.FormatCSV, strings.NewReader(heredoc.Doc(`
+		id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,tags...,customFields...
+		te1,From the CLI,p1,Website,t1,Layout,2026-10-14 09:00:00,2026-10-14 10:30:00,1:30:00,u1,j@d.com,John,Dev (tag1);Meeting (tag2),
+		te2,"Running, so no end",p1,Website,,,2026-10-14 11:00:00,,0:10:00,u1,j@d.com,John,,
+		te3,Broken,,,,,yesterday,,,,,,,
+	`)))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Regexp(t, "^csv:[0-9a-f]{32}$", es[0].Key)
+	assert.NotEqual(t, es[0].Key, es[1].Key)
+
+	es = withoutKeys(es)
+	end := local("2026-10-14 10:30:00")
+	assert.Equal(t, importer.Entry{
+		Line:        2,
+		Description: "From the CLI",
+		Project:     "p1",
+		Task:        "t1",
+		Tags:        []string{"tag1", "tag2"},
+		Start:       local("2026-10-14 09:00:00"),
+		End:         end,
+	}, es[0])
+
+	assert.NoError(t, es[1].Err)
+	assert.Equal(t, "Running, so no end", es[1].Description)
+	assert.Equal(t, "p1", es[1].Project)
+	assert.Equal(t, "", es[1].Task)
+	assert.Equal(t, 10*time.Minute, es[1].End.Sub(es[1].Start))
+
+	assert.Equal(t, 4, es[2].Line)
+	assert.ErrorContains(t, es[2].Err, `time "yesterday" is invalid`)
+}
+
+func TestParseCSV_Names(t *testing.T) {
+	es, err := importer.ParseCSV(strings.NewReader(heredoc.Doc(`
+		start,duration,project,client,task,description,tags,billable
+		2026-10-14 09:00,1h30m,Website,Acme,Layout,Planning,dev; meeting,yes
+		2026-10-14T09:00:00-03:00,PT1H,,,,,,maybe
+		2026-10-14 09:00,-1h,,,,,,
+	`)))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	es = withoutKeys(es)
+	b := true
+	assert.Equal(t, importer.Entry{
+		Line:        2,
+		Description: "Planning",
+		Project:     "Website",
+		Client:      "Acme",
+		Task:        "Layout",
+		Tags:        []string{"dev", "meeting"},
+		Billable:    &b,
+		Start:       local("2026-10-14 09:00:00"),
+		End:         local("2026-10-14 10:30:00"),
+	}, es[0])
+
+	assert.ErrorContains(t, es[1].Err, `billable "maybe" is invalid`)
+	assert.ErrorContains(t, es[2].Err, "end is before start")
+}
+
+func TestParseCSV_RequiresStart(t *testing.T) {
+	_, err := importer.ParseCSV(strings.NewReader("description,end\n"))
+	assert.EqualError(t, err, `csv must have a "start" column`)
+}
+
+func TestParseJSON(t *testing.T) {
+	es, err := importer.Parse(importer.FormatJSON, strings.NewReader(`[
+		{
+			"id": "te1",
+			"description": "From the CLI",
+			"projectId": "p1",
+			"project": {"id": "p1", "name": "Website"},
+			"task": {"id": "t1", "name": "Layout"},
+			"tags": [{"id": "tag1", "name": "Dev"}],
+			"billable": false,
+			"timeInterval": {
+				"start": "2026-10-14T12:00:00Z",
+				"end": "2026-10-14T13:30:00Z",
+				"duration": "PT1H30M"
+			}
+		},
+		{
+			"description": "By name",
+			"project": "Website",
+			"client": "Acme",
+			"task": "Layout",
+			"tags": ["dev"],
+			"start": "2026-10-14 09:00",
+			"duration": "0:45"
+		},
+		{"description": "Running", "timeInterval": {"start": "2026-10-14T12:00:00Z", "end": null}},
+		{"description": 10}
+	]`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Regexp(t, "^json:", es[0].Key)
+
+	es = withoutKeys(es)
+	b := false
+	assert.Equal(t, importer.Entry{
+		Line:        1,
+		Description: "From the CLI",
+		Project:     "p1",
+		Task:        "t1",
+		Tags:        []string{"tag1"},
+		Billable:    &b,
+		Start:       time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+		End:         time.Date(2026, 10, 14, 13, 30, 0, 0, time.UTC),
+	}, es[0])
+
+	assert.Equal(t, importer.Entry{
+		Line:        2,
+		Description: "By name",
+		Project:     "Website",
+		Client:      "Acme",
+		Task:        "Layout",
+		Tags:        []string{"dev"},
+		Start:       local("2026-10-14 09:00:00"),
+		End:         local("2026-10-14 09:45:00"),
+	}, es[1])
+
+	assert.EqualError(t, es[2].Err, "end or duration is required")
+	assert.Error(t, es[3].Err)
+}
+
+func TestParseJSON_SingleObject(t *testing.T) {
+	es, err := importer.ParseJSON(strings.NewReader(
+		`{"start": "2026-10-14T12:00:00Z", "duration": "1h"}`))
+	if !assert.NoError(t, err) || !assert.Len(t, es, 1) {
+		return
+	}
+
+	assert.NoError(t, es[0].Err)
+}
+
+func TestFormatFromFilename(t *testing.T) {
+	f, err := importer.FormatFromFilename("/tmp/Entries.CSV")
+	assert.NoError(t, err)
+	assert.Equal(t, importer.FormatCSV, f)
+
+	_, err = importer.FormatFromFilename("entries.txt")
+	assert.EqualError(t, err,
+		`can't detect the format of "entries.txt", set it with --from`)
+
+	_, err = importer.Parse("xml", strings.NewReader(""))
+	assert.EqualError(t, err,
+		"format \"xml\" is not supported, use one of: "+
+			"csv, harvest, json, timewarrior, toggl")
+
+	_, err = importer.FormatFromFilename("entries.toggl")
+	assert.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// jsonRef is a project, task or tag, informed as a string (ID or name) or as
+// a object with "id" and "name"
+type jsonRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON accepts a string or a object
+func (r *jsonRef) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		r.Name = s
+		return nil
+	}
+
+	type ref jsonRef
+	return json.Unmarshal(b, (*ref)(r))
+}
+
+func (r *jsonRef) String() string {
+	if r == nil {
+		return ""
+	}
+
+	if r.ID != "" {
+		return r.ID
+	}
+
+	return strings.TrimSpace(r.Name)
+}
+
+type jsonInterval struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Duration string `json:"duration"`
+}
+
+type jsonEntry struct {
+	Description string    `json:"description"`
+	ProjectID   string    `json:"projectId"`
+	Project     *jsonRef  `json:"project"`
+	Client      string    `json:"client"`
+	TaskID      string    `json:"taskId"`
+	Task        *jsonRef  `json:"task"`
+	TagIDs      []string  `json:"tagIds"`
+	Tags        []jsonRef `json:"tags"`
+	Billable    *bool     `json:"billable"`
+
+	TimeInterval *jsonInterval `json:"timeInterval"`
+	jsonInterval
+}
+
+// ParseJSON reads time entries from a JSON list (or a single object), like
+// the ones printed with --json. Each time entry may inform the project, task
+// and tags as the CLI prints them, or using their names:
+//
+//	{"description": "...", "project": "name", "task": "name",
+//	 "client": "name", "tags": ["name"], "billable": true,
+//	 "start": "...", "end": "...", "duration": "1:30"}
+func ParseJSON(r io.Reader) ([]Entry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '{' {
+		b = append(append([]byte{'['}, b...), ']')
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil {
+		return nil, fmt.Errorf("reading json: %w", err)
+	}
+
+	es := make([]Entry, len(raws))
+	for i := range raws {
+		es[i] = jsonToEntry(i+1, raws[i])
+	}
+
+	return es, nil
+}
+
+func jsonToEntry(line int, raw json.RawMessage) Entry {
+	e := Entry{Line: line, Key: entryKey(FormatJSON, string(raw))}
+
+	var j jsonEntry
+	if err := json.Unmarshal(raw, &j); err != nil {
+		e.Err = err
+		return e
+	}
+
+	e.Description = j.Description
+	e.Project = j.ProjectID
+	if e.Project == "" {
+		e.Project = j.Project.String()
+	}
+
+	e.Client = j.Client
+	e.Task = j.TaskID
+	if e.Task == "" {
+		e.Task = j.Task.String()
+	}
+
+	e.Tags = j.TagIDs
+	if len(e.Tags) == 0 {
+		for i := range j.Tags {
+			e.Tags = append(e.Tags, j.Tags[i].String())
+		}
+	}
+
+	e.Billable = j.Billable
+
+	i := j.jsonInterval
+	if j.TimeInterval != nil {
+		i = *j.TimeInterval
+	}
+
+	e.Err = e.setInterval(i.Start, i.End, i.Duration)
+	return e
+}
@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Record registers that a entry was imported as a time entry
+type Record struct {
+	Workspace   string    `json:"workspace"`
+	Key         string    `json:"key"`
+	TimeEntryID string    `json:"timeEntryId"`
+	ImportedAt  time.Time `json:"importedAt"`
+}
+
+// Ledger keeps track of the entries already imported, so importing the same
+// file twice will not duplicate time entries
+type Ledger struct {
+	path    string
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewLedger returns a ledger stored at the file path, which is created when
+// the first entry is recorded
+func NewLedger(path string) *Ledger {
+	return &Ledger{path: path}
+}
+
+func ledgerKey(workspace, key string) string {
+	return workspace + "/" + key
+}
+
+func (l *Ledger) load() error {
+	if l.records != nil {
+		return nil
+	}
+
+	l.records = map[string]Record{}
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "open import ledger")
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var r Record
+		if err := json.Unmarshal(s.Bytes(), &r); err != nil {
+			return errors.Wrap(err, "read import ledger")
+		}
+
+		l.records[ledgerKey(r.Workspace, r.Key)] = r
+	}
+
+	return errors.Wrap(s.Err(), "read import ledger")
+}
+
+// Get returns the record of a entry imported into the workspace, if it was
+func (l *Ledger) Get(workspace, key string) (Record, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.load(); err != nil {
+		return Record{}, false, err
+	}
+
+	r, ok := l.records[ledgerKey(workspace, key)]
+	return r, ok, nil
+}
+
+// Add records that the entry was imported as the time entry
+func (l *Ledger) Add(workspace, key, timeEntryID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.load(); err != nil {
+		return err
+	}
+
+	r := Record{
+		Workspace:   workspace,
+		Key:         key,
+		TimeEntryID: timeEntryID,
+		ImportedAt:  time.Now().UTC(),
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return errors.Wrap(err, "create import ledger")
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "open import ledger")
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		return errors.Wrap(err, "write import ledger")
+	}
+
+	l.records[ledgerKey(workspace, key)] = r
+	return nil
+}
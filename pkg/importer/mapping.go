@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Mapping translates the names of projects, clients and tags used by other
+// time trackers into the ones used on Clockify
+//
+//	projects:
+//	  Old Project: New Project
+//	clients:
+//	  Old Client: New Client
+//	tags:
+//	  old-tag: new-tag
+//	  ignored-tag: ""
+//
+// Tags mapped to a empty string are dropped, and tags that are keys of
+// projects set the project of entries without one (useful for Timewarrior,
+// which only has tags)
+type Mapping struct {
+	Projects map[string]string `yaml:"projects" json:"projects"`
+	Clients  map[string]string `yaml:"clients" json:"clients"`
+	Tags     map[string]string `yaml:"tags" json:"tags"`
+}
+
+// ReadMapping reads a mapping from a YAML (or JSON) file
+func ReadMapping(path string) (Mapping, error) {
+	var m Mapping
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "read mapping "+path)
+	}
+
+	return m, nil
+}
+
+// Apply returns the entry with its project, client and tags mapped
+func (m Mapping) Apply(e Entry) Entry {
+	if v, ok := m.Projects[e.Project]; ok && e.Project != "" {
+		e.Project = v
+	}
+
+	if v, ok := m.Clients[e.Client]; ok && e.Client != "" {
+		e.Client = v
+	}
+
+	if len(e.Tags) == 0 {
+		return e
+	}
+
+	tags := make([]string, 0, len(e.Tags))
+	for _, t := range e.Tags {
+		if p, ok := m.Projects[t]; ok && e.Project == "" {
+			e.Project = p
+			continue
+		}
+
+		if v, ok := m.Tags[t]; ok {
+			t = v
+		}
+
+		if t != "" {
+			tags = append(tags, t)
+		}
+	}
+	e.Tags = tags
+
+	return e
+}
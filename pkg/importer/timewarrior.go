@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// FormatTimewarrior is the JSON printed by "timew export"
+const FormatTimewarrior Format = "timewarrior"
+
+const timewarriorTimeFormat = "20060102T150405Z"
+
+type timewarriorInterval struct {
+	Start      string   `json:"start"`
+	End        string   `json:"end"`
+	Tags       []string `json:"tags"`
+	Annotation string   `json:"annotation"`
+}
+
+// ParseTimewarrior reads time entries from the JSON printed by
+// "timew export". The annotation is used as description, and the tags as
+// tags (use a mapping to set the project of the entries using them).
+// Intervals still open can't be imported
+func ParseTimewarrior(r io.Reader) ([]Entry, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raws); err != nil {
+		return nil, fmt.Errorf("reading timewarrior export: %w", err)
+	}
+
+	es := make([]Entry, len(raws))
+	for i := range raws {
+		es[i] = timewarriorToEntry(i+1, raws[i])
+	}
+
+	return es, nil
+}
+
+func timewarriorToEntry(line int, raw json.RawMessage) Entry {
+	e := Entry{Line: line}
+
+	var ti timewarriorInterval
+	if err := json.Unmarshal(raw, &ti); err != nil {
+		e.Err = err
+		return e
+	}
+
+	// the start identifies the interval, as the ids are renumbered and
+	// the tags and annotation may change
+	e.Key = entryKey(FormatTimewarrior, ti.Start)
+	e.Description = strings.TrimSpace(ti.Annotation)
+	e.Tags = ti.Tags
+
+	var err error
+	if e.Start, err = time.Parse(timewarriorTimeFormat, ti.Start); err != nil {
+		e.Err = fmt.Errorf("start \"%s\" is invalid", ti.Start)
+		return e
+	}
+
+	if ti.End == "" {
+		e.Err = errors.New("interval is still open")
+		return e
+	}
+
+	if e.End, err = time.Parse(timewarriorTimeFormat, ti.End); err != nil {
+		e.Err = fmt.Errorf("end \"%s\" is invalid", ti.End)
+	}
+
+	return e
+}
+
+// TimewarriorReport is what Timewarrior sends to the extensions on stdin:
+// its configuration, followed by the intervals of the report
+type TimewarriorReport struct {
+	Config  map[string]string
+	Entries []Entry
+}
+
+const (
+	// TimewarriorMappingConfig is the Timewarrior config with the path of a
+	// mapping file
+	TimewarriorMappingConfig = "clockify.mapping"
+	// TimewarriorProjectConfig is the prefix of Timewarrior configs mapping
+	// a tag to a project, like "clockify.project.website: Website"
+	TimewarriorProjectConfig = "clockify.project."
+)
+
+// ParseTimewarriorReport reads the input of a Timewarrior extension, which
+// has a header with one "name: value" config per line, a empty line and the
+// intervals as printed by "timew export"
+func ParseTimewarriorReport(r io.Reader) (TimewarriorReport, error) {
+	rep := TimewarriorReport{Config: map[string]string{}}
+	br := bufio.NewReader(r)
+	for {
+		l, err := br.ReadString('\n')
+		if err == io.EOF {
+			return rep, errors.New("timewarrior report has no intervals")
+		}
+
+		if err != nil {
+			return rep, err
+		}
+
+		l = strings.TrimRight(l, "\r\n")
+		if l == "" {
+			break
+		}
+
+		if k, v, ok := strings.Cut(l, ":"); ok {
+			rep.Config[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	var err error
+	rep.Entries, err = ParseTimewarrior(br)
+	return rep, err
+}
+
+// Mapping returns the mapping set on the Timewarrior config, reading the
+// file of "clockify.mapping" and adding the "clockify.project.<tag>" configs
+// to its projects
+func (r TimewarriorReport) Mapping() (Mapping, error) {
+	m := Mapping{}
+	if p := r.Config[TimewarriorMappingConfig]; p != "" {
+		var err error
+		if m, err = ReadMapping(p); err != nil {
+			return m, err
+		}
+	}
+
+	for k, v := range r.Config {
+		if !strings.HasPrefix(k, TimewarriorProjectConfig) {
+			continue
+		}
+
+		if m.Projects == nil {
+			m.Projects = map[string]string{}
+		}
+		m.Projects[strings.TrimPrefix(k, TimewarriorProjectConfig)] = v
+	}
+
+	return m, nil
+}
@@ -0,0 +1,37 @@
+package importer
+
+import (
+	"io"
+)
+
+// FormatToggl is the detailed CSV export of Toggl Track
+const FormatToggl Format = "toggl"
+
+// ParseToggl reads time entries from a detailed CSV export of Toggl Track,
+// using the columns: Client, Project, Task, Description, Billable, Start
+// date, Start time, End date, End time, Duration and Tags (separated by ",")
+func ParseToggl(r io.Reader) ([]Entry, error) {
+	return readCSV(r, FormatToggl, []string{"start date", "start time"},
+		func(e *Entry, c csvRow) error {
+			e.Description = c.get("description")
+			e.Project = c.get("project")
+			e.Client = c.get("client")
+			e.Task = c.get("task")
+			e.Tags = splitList(c.get("tags"), ",")
+
+			if err := c.billable(e, "billable"); err != nil {
+				return err
+			}
+
+			end := ""
+			if d := c.get("end date"); d != "" {
+				end = d + " " + c.get("end time")
+			}
+
+			return e.setInterval(
+				c.get("start date")+" "+c.get("start time"),
+				end,
+				c.get("duration"),
+			)
+		})
+}
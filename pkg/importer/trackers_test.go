@@ -0,0 +1,209 @@
+package importer_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseToggl(t *testing.T) {
+	es, err := importer.Parse(importer.FormatToggl, strings.NewReader(
+		"\ufeff"+heredoc.Doc(`
+		User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags,Amount (USD)
+		John,j@d.com,Acme,Website,Layout,Planning,Yes,2026-10-14,09:00:00,2026-10-14,10:30:00,01:30:00,"dev, meeting",75.00
+		John,j@d.com,,,,Lunch,No,2026-10-14,12:00:00,,,01:00:00,,
+		John,j@d.com,,,,Broken,No,14/10/2026,12:00:00,,,01:00:00,,
+	`)))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Regexp(t, "^toggl:", es[0].Key)
+
+	es = withoutKeys(es)
+	b := true
+	assert.Equal(t, importer.Entry{
+		Line:        2,
+		Description: "Planning",
+		Project:     "Website",
+		Client:      "Acme",
+		Task:        "Layout",
+		Tags:        []string{"dev", "meeting"},
+		Billable:    &b,
+		Start:       local("2026-10-14 09:00:00"),
+		End:         local("2026-10-14 10:30:00"),
+	}, es[0])
+
+	assert.NoError(t, es[1].Err)
+	assert.Equal(t, local("2026-10-14 13:00:00"), es[1].End)
+	assert.False(t, *es[1].Billable)
+
+	assert.Error(t, es[2].Err)
+}
+
+func TestParseHarvest(t *testing.T) {
+	es, err := importer.Parse(importer.FormatHarvest, strings.NewReader(
+		heredoc.Doc(`
+		Date,Client,Project,Project Code,Task,Notes,Hours,Hours Rounded,Billable?,Invoiced?
+		2026-10-14,Acme,Website,W1,Design,Planning,1.5,1.5,Yes,No
+		2026-10-14,Acme,Website,W1,Design,Review,0.25,0.25,No,No
+		2026-10-15,Acme,App,A1,Dev,,2,2,Yes,No
+		2026-10-15,Acme,App,A1,Dev,,two,2,Yes,No
+	`)))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	es = withoutKeys(es)
+	b := true
+	assert.Equal(t, importer.Entry{
+		Line:        2,
+		Description: "Planning",
+		Project:     "Website",
+		Client:      "Acme",
+		Task:        "Design",
+		Billable:    &b,
+		Start:       local("2026-10-14 09:00:00"),
+		End:         local("2026-10-14 10:30:00"),
+	}, es[0])
+
+	assert.Equal(t, local("2026-10-14 10:30:00"), es[1].Start)
+	assert.Equal(t, local("2026-10-14 10:45:00"), es[1].End)
+
+	assert.Equal(t, local("2026-10-15 09:00:00"), es[2].Start)
+	assert.Equal(t, local("2026-10-15 11:00:00"), es[2].End)
+
+	assert.EqualError(t, es[3].Err, `hours "two" is invalid`)
+}
+
+func TestParseTimewarrior(t *testing.T) {
+	es, err := importer.Parse(importer.FormatTimewarrior, strings.NewReader(`[
+		{"id":3,"start":"20261014T120000Z","end":"20261014T133000Z","tags":["website","dev"],"annotation":"Planning"},
+		{"id":2,"start":"20261014T140000Z","end":"20261014T150000Z"},
+		{"id":1,"start":"20261014T160000Z","tags":["website"]}
+	]`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	again, _ := importer.ParseTimewarrior(strings.NewReader(`[
+		{"id":1,"start":"20261014T120000Z","end":"20261014T133000Z","tags":["other"]}
+	]`))
+	assert.Equal(t, again[0].Key, es[0].Key)
+
+	es = withoutKeys(es)
+	assert.Equal(t, importer.Entry{
+		Line:        1,
+		Description: "Planning",
+		Tags:        []string{"website", "dev"},
+		Start:       time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+		End:         time.Date(2026, 10, 14, 13, 30, 0, 0, time.UTC),
+	}, es[0])
+
+	assert.NoError(t, es[1].Err)
+	assert.EqualError(t, es[2].Err, "interval is still open")
+}
+
+func TestMapping(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "mapping.yaml")
+	_ = os.WriteFile(file, []byte(heredoc.Doc(`
+		projects:
+		  Old Website: Website
+		  website: Website
+		clients:
+		  ACME Inc: Acme
+		tags:
+		  dev: development
+		  ignored: ""
+	`)), 0644)
+
+	m, err := importer.ReadMapping(file)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, importer.Entry{
+		Project: "Website",
+		Client:  "Acme",
+		Tags:    []string{"development", "meeting"},
+	}, m.Apply(importer.Entry{
+		Project: "Old Website",
+		Client:  "ACME Inc",
+		Tags:    []string{"dev", "ignored", "meeting"},
+	}))
+
+	assert.Equal(t, importer.Entry{
+		Project: "Website",
+		Tags:    []string{"development"},
+	}, m.Apply(importer.Entry{
+		Tags: []string{"website", "dev"},
+	}))
+
+	assert.Equal(t, importer.Entry{
+		Project: "Other",
+		Tags:    []string{"website"},
+	}, m.Apply(importer.Entry{
+		Project: "Other",
+		Tags:    []string{"website"},
+	}))
+}
+
+func TestLedger(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config", "import-ledger")
+
+	l := importer.NewLedger(file)
+	_, ok, err := l.Get("w1", "csv:1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, l.Add("w1", "csv:1", "te1"))
+	assert.NoError(t, l.Add("w1", "csv:2", "te2"))
+
+	l = importer.NewLedger(file)
+	r, ok, err := l.Get("w1", "csv:2")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "te2", r.TimeEntryID)
+
+	_, ok, _ = l.Get("w2", "csv:1")
+	assert.False(t, ok)
+}
+
+func TestParseTimewarriorReport(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "mapping.yaml")
+	_ = os.WriteFile(file, []byte("tags:\n  dev: development\n"), 0644)
+
+	rep, err := importer.ParseTimewarriorReport(strings.NewReader(
+		heredoc.Doc(`
+		temp.report.start: 20261014T000000Z
+		clockify.mapping: ` + file + `
+		clockify.project.website: Website
+
+		[
+		{"id":1,"start":"20261014T120000Z","end":"20261014T133000Z","tags":["website","dev"]}
+		]
+	`)))
+	if !assert.NoError(t, err) || !assert.Len(t, rep.Entries, 1) {
+		return
+	}
+
+	assert.Equal(t, "20261014T000000Z", rep.Config["temp.report.start"])
+
+	m, err := rep.Mapping()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	e := m.Apply(rep.Entries[0])
+	assert.Equal(t, "Website", e.Project)
+	assert.Equal(t, []string{"development"}, e.Tags)
+
+	_, err = importer.ParseTimewarriorReport(strings.NewReader("a: b\n"))
+	assert.EqualError(t, err, "timewarrior report has no intervals")
+}
@@ -0,0 +1,504 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+type client struct {
+	api.Client
+	j      *Journal
+	notice io.Writer
+}
+
+// NewClient decorates a api.Client so changes on time entries are recorded on
+// the Journal when the API is unreachable, or when there are other changes
+// waiting to be synced. Time entries reads will consider the pending changes.
+//
+// A message is written to notice every time a change is recorded.
+func NewClient(c api.Client, j *Journal, notice io.Writer) api.Client {
+	return &client{Client: c, j: j, notice: notice}
+}
+
+// isUnreachable returns true for failures to reach the API, instead of
+// errors answered by it
+func isUnreachable(err error) bool {
+	var ue *url.Error
+	return errors.As(err, &ue) && !errors.Is(err, context.Canceled)
+}
+
+func (c *client) SetDebugLogger(logger api.Logger) api.Client {
+	c.Client.SetDebugLogger(logger)
+	return c
+}
+
+func (c *client) SetInfoLogger(logger api.Logger) api.Client {
+	c.Client.SetInfoLogger(logger)
+	return c
+}
+
+func (c *client) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	c.Client.SetRetryPolicy(p)
+	return c
+}
+
+func (c *client) WithContext(ctx context.Context) api.Client {
+	return &client{
+		Client: c.Client.WithContext(ctx),
+		j:      c.j,
+		notice: c.notice,
+	}
+}
+
+// resolveID changes ids of time entries created offline and already synced
+// to their remote ids; local reports if the time entry still only exists on
+// the journal
+func (c *client) resolveID(id string) (_ string, local bool, err error) {
+	if !IsLocalID(id) {
+		return id, false, nil
+	}
+
+	rid, ok, err := c.j.RemoteID(id)
+	if err != nil || !ok {
+		return id, true, err
+	}
+
+	return rid, false, nil
+}
+
+// mustRecord checks if a change should go directly to the journal
+func (c *client) mustRecord(workspace string, local bool) (bool, error) {
+	if local {
+		return true, nil
+	}
+
+	return c.j.HasPending(workspace)
+}
+
+func (c *client) record(o Operation, pending bool) (Operation, error) {
+	o, err := c.j.Record(o)
+	if err != nil {
+		return o, err
+	}
+
+	reason := "API is unreachable"
+	if pending {
+		reason = "there are changes waiting to be synced"
+	}
+
+	_, _ = fmt.Fprintf(c.notice,
+		"%s, %s was saved to be synced later "+
+			"(run `clockify-cli sync` to send it)\n",
+		reason, o.Description())
+
+	return o, nil
+}
+
+func (c *client) base(workspace, id string) (*dto.TimeEntryImpl, error) {
+	v, err := c.j.View(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := v[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &t, nil
+}
+
+func (c *client) CreateTimeEntry(p api.CreateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	if c.j.IsReplaying() {
+		return c.Client.CreateTimeEntry(p)
+	}
+
+	pending, err := c.mustRecord(p.Workspace, false)
+	if err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	if !pending {
+		te, err := c.Client.CreateTimeEntry(p)
+		if err == nil {
+			_ = c.j.Remember(te)
+		}
+
+		if !isUnreachable(err) {
+			return te, err
+		}
+	}
+
+	o, err := c.record(Operation{
+		Kind:      KindCreate,
+		Workspace: p.Workspace,
+		Create:    &p,
+	}, pending)
+	if err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	v := map[string]dto.TimeEntryImpl{}
+	apply(v, o)
+	return v[o.TimeEntryID], nil
+}
+
+func (c *client) Out(p api.OutParam) error {
+	if c.j.IsReplaying() {
+		return c.Client.Out(p)
+	}
+
+	pending, err := c.mustRecord(p.Workspace, false)
+	if err != nil {
+		return err
+	}
+
+	if !pending {
+		err := c.Client.Out(p)
+		if err == nil {
+			_ = c.j.ForgetRunning(p.Workspace, p.UserID)
+		}
+
+		if !isUnreachable(err) {
+			return err
+		}
+	}
+
+	o := Operation{
+		Kind:      KindOut,
+		Workspace: p.Workspace,
+		Out:       &p,
+	}
+
+	if o.Base, err = c.j.Running(p.Workspace, p.UserID); err != nil {
+		return err
+	}
+
+	if o.Base != nil {
+		o.TimeEntryID = o.Base.ID
+	}
+
+	_, err = c.record(o, pending)
+	return err
+}
+
+func (c *client) UpdateTimeEntry(p api.UpdateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	if c.j.IsReplaying() {
+		return c.Client.UpdateTimeEntry(p)
+	}
+
+	var local bool
+	var err error
+	if p.TimeEntryID, local, err = c.resolveID(p.TimeEntryID); err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	pending, err := c.mustRecord(p.Workspace, local)
+	if err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	if !pending {
+		te, err := c.Client.UpdateTimeEntry(p)
+		if err == nil {
+			_ = c.j.Remember(te)
+		}
+
+		if !isUnreachable(err) {
+			return te, err
+		}
+	}
+
+	o := Operation{
+		Kind:        KindUpdate,
+		Workspace:   p.Workspace,
+		TimeEntryID: p.TimeEntryID,
+		Update:      &p,
+	}
+
+	if o.Base, err = c.base(p.Workspace, p.TimeEntryID); err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	if o, err = c.record(o, pending); err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	v := map[string]dto.TimeEntryImpl{}
+	if o.Base != nil {
+		v[o.Base.ID] = *o.Base
+	}
+
+	apply(v, o)
+	return v[o.TimeEntryID], nil
+}
+
+func (c *client) DeleteTimeEntry(p api.DeleteTimeEntryParam) error {
+	if c.j.IsReplaying() {
+		return c.Client.DeleteTimeEntry(p)
+	}
+
+	var local bool
+	var err error
+	if p.TimeEntryID, local, err = c.resolveID(p.TimeEntryID); err != nil {
+		return err
+	}
+
+	pending, err := c.mustRecord(p.Workspace, local)
+	if err != nil {
+		return err
+	}
+
+	if !pending {
+		err := c.Client.DeleteTimeEntry(p)
+		if err == nil {
+			_ = c.j.Forget(p.TimeEntryID)
+		}
+
+		if !isUnreachable(err) {
+			return err
+		}
+	}
+
+	o := Operation{
+		Kind:        KindDelete,
+		Workspace:   p.Workspace,
+		TimeEntryID: p.TimeEntryID,
+		Delete:      &p,
+	}
+
+	if o.Base, err = c.base(p.Workspace, p.TimeEntryID); err != nil {
+		return err
+	}
+
+	_, err = c.record(o, pending)
+	return err
+}
+
+func (c *client) GetTimeEntryInProgress(p api.GetTimeEntryInProgressParam) (
+	*dto.TimeEntryImpl, error) {
+	if c.j.IsReplaying() {
+		return c.Client.GetTimeEntryInProgress(p)
+	}
+
+	te, err := c.Client.GetTimeEntryInProgress(p)
+	if err != nil && !isUnreachable(err) {
+		return te, err
+	}
+
+	online := err == nil
+	if online && te != nil {
+		_ = c.j.Remember(*te)
+	} else if online {
+		_ = c.j.ForgetRunning(p.Workspace, p.UserID)
+	}
+
+	pending, err := c.j.HasPending(p.Workspace)
+	if err != nil || (online && !pending) {
+		return te, err
+	}
+
+	return c.j.Running(p.Workspace, p.UserID)
+}
+
+func (c *client) GetHydratedTimeEntryInProgress(
+	p api.GetTimeEntryInProgressParam) (*dto.TimeEntry, error) {
+	if c.j.IsReplaying() {
+		return c.Client.GetHydratedTimeEntryInProgress(p)
+	}
+
+	te, err := c.Client.GetHydratedTimeEntryInProgress(p)
+	if err != nil && !isUnreachable(err) {
+		return te, err
+	}
+
+	online := err == nil
+	if online && te != nil {
+		t := dehydrate(*te)
+		if t.UserID == "" {
+			t.UserID = p.UserID
+		}
+		_ = c.j.Remember(t)
+	} else if online {
+		_ = c.j.ForgetRunning(p.Workspace, p.UserID)
+	}
+
+	pending, err := c.j.HasPending(p.Workspace)
+	if err != nil || (online && !pending) {
+		return te, err
+	}
+
+	t, err := c.j.Running(p.Workspace, p.UserID)
+	if t == nil || err != nil {
+		return nil, err
+	}
+
+	return c.hydrate(*t), nil
+}
+
+// getTimeEntry looks for the time entry on the API, and if it is unreachable
+// or there are pending changes, on the journal
+func (c *client) getTimeEntry(
+	p api.GetTimeEntryParam,
+	fn func(api.GetTimeEntryParam) (*dto.TimeEntryImpl, error),
+) (*dto.TimeEntryImpl, error) {
+	var local bool
+	var err error
+	if p.TimeEntryID, local, err = c.resolveID(p.TimeEntryID); err != nil {
+		return nil, err
+	}
+
+	if !local {
+		te, err := fn(p)
+		if err != nil && !isUnreachable(err) {
+			return te, err
+		}
+
+		online := err == nil
+		if online && te != nil {
+			_ = c.j.Remember(*te)
+		}
+
+		pending, err := c.j.HasPending(p.Workspace)
+		if err != nil || (online && !pending) {
+			return te, err
+		}
+	}
+
+	v, err := c.j.View(p.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := v[p.TimeEntryID]
+	if !ok {
+		return nil, api.EntityNotFound{
+			EntityName: "time entry",
+			ID:         p.TimeEntryID,
+		}
+	}
+
+	return &t, nil
+}
+
+func (c *client) GetTimeEntry(p api.GetTimeEntryParam) (
+	*dto.TimeEntryImpl, error) {
+	if c.j.IsReplaying() {
+		return c.Client.GetTimeEntry(p)
+	}
+
+	return c.getTimeEntry(p, c.Client.GetTimeEntry)
+}
+
+func (c *client) GetHydratedTimeEntry(p api.GetTimeEntryParam) (
+	*dto.TimeEntry, error) {
+	if c.j.IsReplaying() {
+		return c.Client.GetHydratedTimeEntry(p)
+	}
+
+	var hydrated *dto.TimeEntry
+	t, err := c.getTimeEntry(p, func(p api.GetTimeEntryParam) (
+		*dto.TimeEntryImpl, error) {
+		te, err := c.Client.GetHydratedTimeEntry(p)
+		if te == nil || err != nil {
+			return nil, err
+		}
+
+		hydrated = te
+		t := dehydrate(*te)
+		return &t, nil
+	})
+	if t == nil || err != nil {
+		return nil, err
+	}
+
+	if hydrated != nil && hydrated.ID == t.ID && equal(dehydrate(*hydrated), *t) {
+		return hydrated, nil
+	}
+
+	return c.hydrate(*t), nil
+}
+
+// dehydrate converts a time entry with its related entities into the simpler
+// version of it
+func dehydrate(te dto.TimeEntry) dto.TimeEntryImpl {
+	t := dto.TimeEntryImpl{
+		ID:           te.ID,
+		Billable:     te.Billable,
+		Description:  te.Description,
+		IsLocked:     te.IsLocked,
+		ProjectID:    te.ProjectID,
+		TimeInterval: te.TimeInterval,
+		WorkspaceID:  te.WorkspaceID,
+	}
+
+	if te.Task != nil {
+		t.TaskID = te.Task.ID
+	}
+
+	if te.User != nil {
+		t.UserID = te.User.ID
+	}
+
+	for _, tag := range te.Tags {
+		t.TagIDs = append(t.TagIDs, tag.ID)
+	}
+
+	return t
+}
+
+// hydrate fills the related entities of a time entry known only by the
+// journal, the ones that can't be loaded will be left only with their ids
+func (c *client) hydrate(t dto.TimeEntryImpl) *dto.TimeEntry {
+	te := &dto.TimeEntry{
+		ID:           t.ID,
+		Billable:     t.Billable,
+		Description:  t.Description,
+		IsLocked:     t.IsLocked,
+		ProjectID:    t.ProjectID,
+		TimeInterval: t.TimeInterval,
+		WorkspaceID:  t.WorkspaceID,
+	}
+
+	if t.ProjectID != "" {
+		if p, err := c.Client.GetProject(api.GetProjectParam{
+			Workspace: t.WorkspaceID,
+			ProjectID: t.ProjectID,
+		}); err == nil {
+			te.Project = p
+		}
+	}
+
+	if t.TaskID != "" {
+		te.Task = &dto.Task{ID: t.TaskID}
+		if task, err := c.Client.GetTask(api.GetTaskParam{
+			Workspace: t.WorkspaceID,
+			ProjectID: t.ProjectID,
+			TaskID:    t.TaskID,
+		}); err == nil {
+			te.Task = &task
+		}
+	}
+
+	for _, id := range t.TagIDs {
+		tag := dto.Tag{ID: id}
+		if tg, err := c.Client.GetTag(api.GetTagParam{
+			Workspace: t.WorkspaceID,
+			TagID:     id,
+		}); err == nil && tg != nil {
+			tag = *tg
+		}
+
+		te.Tags = append(te.Tags, tag)
+	}
+
+	return te
+}
@@ -0,0 +1,488 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// Kind is the type of change recorded on the journal
+type Kind string
+
+const (
+	KindCreate Kind = "create"
+	KindOut    Kind = "out"
+	KindUpdate Kind = "update"
+	KindDelete Kind = "delete"
+)
+
+// LocalIDPrefix is used on the ids of time entries created while offline
+const LocalIDPrefix = "offline-"
+
+// IsLocalID returns if the time entry id was created while offline
+func IsLocalID(id string) bool {
+	return strings.HasPrefix(id, LocalIDPrefix)
+}
+
+// Operation is a change on time entries that is waiting to be sent to the
+// API
+type Operation struct {
+	Seq        int       `json:"seq"`
+	Kind       Kind      `json:"kind"`
+	RecordedAt time.Time `json:"recordedAt"`
+	Workspace  string    `json:"workspace"`
+	// TimeEntryID is the time entry changed by the operation, for creations
+	// it is the local id, and for outs the time entry expected to be running
+	TimeEntryID string `json:"timeEntryId,omitempty"`
+	// Base is how the time entry was known before the operation, it is used
+	// to detect changes made remotely meanwhile
+	Base *dto.TimeEntryImpl `json:"base,omitempty"`
+
+	Create *api.CreateTimeEntryParam `json:"create,omitempty"`
+	Out    *api.OutParam             `json:"out,omitempty"`
+	Update *api.UpdateTimeEntryParam `json:"update,omitempty"`
+	Delete *api.DeleteTimeEntryParam `json:"delete,omitempty"`
+}
+
+// Description returns a short text describing the operation
+func (o Operation) Description() string {
+	switch o.Kind {
+	case KindCreate:
+		return "create time entry"
+	case KindOut:
+		return "stop running time entry"
+	case KindUpdate:
+		return "update time entry"
+	case KindDelete:
+		return "delete time entry"
+	default:
+		return string(o.Kind)
+	}
+}
+
+type resolution struct {
+	Seq         int    `json:"seq"`
+	TimeEntryID string `json:"timeEntryId,omitempty"`
+	Discarded   bool   `json:"discarded,omitempty"`
+}
+
+// compaction replaces the records of a journal without pending operations,
+// keeping the last sequence used and the remote ids of the time entries
+// created offline, which may still be referenced by their local ids
+type compaction struct {
+	LastSeq  int               `json:"lastSeq"`
+	RemoteID map[string]string `json:"remoteId,omitempty"`
+}
+
+// record is a line of the journal file, it either registers a new operation,
+// that a operation was resolved (synced or discarded) or what was kept of the
+// resolved operations after compacting the file
+type record struct {
+	Op        *Operation  `json:"op,omitempty"`
+	Resolved  *resolution `json:"resolved,omitempty"`
+	Compacted *compaction `json:"compacted,omitempty"`
+}
+
+// Journal keeps a append-only file with the changes to time entries made
+// while the API was unreachable, and what is known about the time entries
+// so they can be shown while offline
+type Journal struct {
+	dir string
+	now func() time.Time
+
+	m         sync.Mutex
+	replaying bool
+}
+
+// Option changes optional behaviors of the Journal
+type Option func(*Journal)
+
+// WithNow sets which function will be used to get the current time
+func WithNow(now func() time.Time) Option {
+	return func(j *Journal) {
+		j.now = now
+	}
+}
+
+// New creates a Journal that stores its files at dir
+func New(dir string, opts ...Option) *Journal {
+	j := &Journal{
+		dir: dir,
+		now: time.Now,
+	}
+
+	for _, o := range opts {
+		o(j)
+	}
+
+	return j
+}
+
+func (j *Journal) filename() string {
+	return filepath.Join(j.dir, "journal.jsonl")
+}
+
+func (j *Journal) knownFilename() string {
+	return filepath.Join(j.dir, "known.json")
+}
+
+// state is the result of reading all the records of the journal
+type state struct {
+	lastSeq  int
+	pending  []Operation
+	remoteID map[string]string
+}
+
+func (j *Journal) read() (state, error) {
+	s := state{remoteID: map[string]string{}}
+
+	f, err := os.Open(j.filename())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+
+		return s, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	ops := map[int]Operation{}
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+
+		var r record
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			return s, errors.Wrap(err, "offline journal is corrupted")
+		}
+
+		switch {
+		case r.Compacted != nil:
+			if r.Compacted.LastSeq > s.lastSeq {
+				s.lastSeq = r.Compacted.LastSeq
+			}
+
+			for l, id := range r.Compacted.RemoteID {
+				s.remoteID[l] = id
+			}
+		case r.Op != nil:
+			ops[r.Op.Seq] = *r.Op
+			if r.Op.Seq > s.lastSeq {
+				s.lastSeq = r.Op.Seq
+			}
+		case r.Resolved != nil:
+			op, ok := ops[r.Resolved.Seq]
+			if !ok {
+				continue
+			}
+
+			delete(ops, r.Resolved.Seq)
+			if op.Kind == KindCreate && r.Resolved.TimeEntryID != "" {
+				s.remoteID[op.TimeEntryID] = r.Resolved.TimeEntryID
+			}
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return s, errors.WithStack(err)
+	}
+
+	for _, op := range ops {
+		s.pending = append(s.pending, op)
+	}
+
+	sort.Slice(s.pending, func(i, k int) bool {
+		return s.pending[i].Seq < s.pending[k].Seq
+	})
+
+	return s, nil
+}
+
+func (j *Journal) append(r record) error {
+	if err := os.MkdirAll(j.dir, 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	f, err := os.OpenFile(
+		j.filename(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err = f.Write(append(b, '\n')); err != nil {
+		_ = f.Close()
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(f.Close())
+}
+
+// Pending returns the operations not synced yet, in the order they were
+// recorded
+func (j *Journal) Pending() ([]Operation, error) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	s, err := j.read()
+	return s.pending, err
+}
+
+// HasPending returns if there are operations waiting to be synced for the
+// workspace
+func (j *Journal) HasPending(workspace string) (bool, error) {
+	ops, err := j.Pending()
+	if err != nil {
+		return false, err
+	}
+
+	for _, o := range ops {
+		if o.Workspace == workspace {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Record appends a operation to the journal, setting its sequence, and local
+// id for creations
+func (j *Journal) Record(o Operation) (Operation, error) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	s, err := j.read()
+	if err != nil {
+		return o, err
+	}
+
+	o.Seq = s.lastSeq + 1
+	o.RecordedAt = j.now()
+	if o.Kind == KindCreate {
+		o.TimeEntryID = fmt.Sprintf("%s%d", LocalIDPrefix, o.Seq)
+	}
+
+	return o, j.append(record{Op: &o})
+}
+
+// resolve marks a operation as done, if all operations are done the journal
+// file is compacted to keep only the remote ids of the time entries created
+// offline
+func (j *Journal) resolve(r resolution) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	if err := j.append(record{Resolved: &r}); err != nil {
+		return err
+	}
+
+	s, err := j.read()
+	if err != nil || len(s.pending) != 0 {
+		return err
+	}
+
+	return j.compact(s)
+}
+
+// compact rewrites the journal file with a single record with the last
+// sequence and remote ids of the state
+func (j *Journal) compact(s state) error {
+	b, err := json.Marshal(record{Compacted: &compaction{
+		LastSeq:  s.lastSeq,
+		RemoteID: s.remoteID,
+	}})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	f, err := os.CreateTemp(j.dir, ".journal-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(f.Name(), j.filename()))
+}
+
+// RemoteID returns the id a time entry created while offline received after
+// being synced
+func (j *Journal) RemoteID(localID string) (string, bool, error) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	s, err := j.read()
+	if err != nil {
+		return "", false, err
+	}
+
+	id, ok := s.remoteID[localID]
+	return id, ok, nil
+}
+
+// maxKnown is how many time entries are kept on the known list
+const maxKnown = 50
+
+func (j *Journal) readKnown() (map[string]dto.TimeEntryImpl, error) {
+	k := map[string]dto.TimeEntryImpl{}
+	b, err := os.ReadFile(j.knownFilename())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return k, nil
+		}
+
+		return k, errors.WithStack(err)
+	}
+
+	if err := json.Unmarshal(b, &k); err != nil {
+		return map[string]dto.TimeEntryImpl{}, nil
+	}
+
+	return k, nil
+}
+
+func (j *Journal) writeKnown(k map[string]dto.TimeEntryImpl) error {
+	if len(k) > maxKnown {
+		tes := make([]dto.TimeEntryImpl, 0, len(k))
+		for _, t := range k {
+			tes = append(tes, t)
+		}
+
+		sort.Slice(tes, func(i, l int) bool {
+			if (tes[i].TimeInterval.End == nil) !=
+				(tes[l].TimeInterval.End == nil) {
+				return tes[i].TimeInterval.End == nil
+			}
+
+			return tes[i].TimeInterval.Start.After(tes[l].TimeInterval.Start)
+		})
+
+		for _, t := range tes[maxKnown:] {
+			delete(k, t.ID)
+		}
+	}
+
+	b, err := json.Marshal(k)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(j.dir, 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	f, err := os.CreateTemp(j.dir, ".known-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(f.Name(), j.knownFilename()))
+}
+
+// Remember stores the last state seen of time entries
+func (j *Journal) Remember(tes ...dto.TimeEntryImpl) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	k, err := j.readKnown()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tes {
+		k[t.ID] = t
+	}
+
+	return j.writeKnown(k)
+}
+
+// Forget removes time entries from the known list
+func (j *Journal) Forget(ids ...string) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	k, err := j.readKnown()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		delete(k, id)
+	}
+
+	return j.writeKnown(k)
+}
+
+// ForgetRunning removes the known running time entries of the user, used when
+// the API confirms that there is no time entry running
+func (j *Journal) ForgetRunning(workspace, userID string) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	k, err := j.readKnown()
+	if err != nil {
+		return err
+	}
+
+	for id, t := range k {
+		if t.WorkspaceID == workspace && t.UserID == userID &&
+			t.TimeInterval.End == nil {
+			delete(k, id)
+		}
+	}
+
+	return j.writeKnown(k)
+}
+
+// IsReplaying returns if the journal is being synced at the moment, so changes
+// should go straight to the API
+func (j *Journal) IsReplaying() bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	return j.replaying
+}
+
+func (j *Journal) setReplaying(b bool) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	j.replaying = b
+}
@@ -0,0 +1,282 @@
+package journal_test
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errUnreachable = &url.Error{
+	Op:  "Get",
+	URL: "https://api.clockify.me/api",
+	Err: errors.New("dial tcp: lookup api.clockify.me: no such host"),
+}
+
+func newJournal(t *testing.T) *journal.Journal {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	return journal.New(t.TempDir(),
+		journal.WithNow(func() time.Time { return now }))
+}
+
+func TestClientRecordsWhenUnreachable(t *testing.T) {
+	j := newJournal(t)
+	m := mocks.NewMockClient(t)
+	notice := &bytes.Buffer{}
+	c := journal.NewClient(m, j, notice)
+
+	start := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	running := dto.TimeEntryImpl{
+		ID:           "te1",
+		UserID:       "u",
+		WorkspaceID:  "w",
+		Description:  "Reading",
+		TimeInterval: dto.NewTimeInterval(start, nil),
+	}
+
+	pp := api.GetTimeEntryInProgressParam{Workspace: "w", UserID: "u"}
+	m.EXPECT().GetTimeEntryInProgress(pp).Return(&running, nil).Once()
+
+	te, err := c.GetTimeEntryInProgress(pp)
+	assert.NoError(t, err)
+	assert.Equal(t, &running, te)
+
+	end := start.Add(time.Hour)
+	op := api.OutParam{Workspace: "w", UserID: "u", End: end}
+	m.EXPECT().Out(op).Return(errUnreachable).Once()
+	assert.NoError(t, c.Out(op))
+
+	cp := api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       end,
+		Description: "Writing",
+	}
+
+	// API is not called again while there are changes pending
+	created, err := c.CreateTimeEntry(cp)
+	assert.NoError(t, err)
+	assert.Equal(t, "offline-2", created.ID)
+	assert.Equal(t, "Writing", created.Description)
+
+	assert.Contains(t, notice.String(), "API is unreachable, "+
+		"stop running time entry was saved to be synced later")
+	assert.Contains(t, notice.String(), "there are changes waiting to be "+
+		"synced, create time entry was saved to be synced later")
+
+	m.EXPECT().GetTimeEntryInProgress(pp).Return(nil, errUnreachable).Once()
+	te, err = c.GetTimeEntryInProgress(pp)
+	assert.NoError(t, err)
+	if assert.NotNil(t, te) {
+		assert.Equal(t, "offline-2", te.ID)
+	}
+
+	te, err = c.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "offline-2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Writing", te.Description)
+
+	ops, err := j.Pending()
+	assert.NoError(t, err)
+	if assert.Len(t, ops, 2) {
+		assert.Equal(t, journal.KindOut, ops[0].Kind)
+		assert.Equal(t, "te1", ops[0].TimeEntryID)
+		assert.Equal(t, journal.KindCreate, ops[1].Kind)
+	}
+}
+
+func TestClientDoesNotRecordAPIErrors(t *testing.T) {
+	j := newJournal(t)
+	m := mocks.NewMockClient(t)
+	c := journal.NewClient(m, j, &bytes.Buffer{})
+
+	p := api.DeleteTimeEntryParam{Workspace: "w", TimeEntryID: "te1"}
+	m.EXPECT().DeleteTimeEntry(p).Return(api.ErrorForbidden).Once()
+
+	assert.ErrorIs(t, c.DeleteTimeEntry(p), api.ErrorForbidden)
+
+	ops, err := j.Pending()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 0)
+}
+
+func TestReplay(t *testing.T) {
+	j := newJournal(t)
+	m := mocks.NewMockClient(t)
+	c := journal.NewClient(m, j, &bytes.Buffer{})
+
+	start := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	m.EXPECT().CreateTimeEntry(mock.Anything).
+		Return(dto.TimeEntryImpl{}, errUnreachable).Once()
+
+	created, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: "w", Start: start, Description: "Writing",
+	})
+	assert.NoError(t, err)
+
+	up := api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: created.ID,
+		Start:       start,
+		End:         &end,
+		Description: "Writing docs",
+	}
+	_, err = c.UpdateTimeEntry(up)
+	assert.NoError(t, err)
+
+	pp := api.GetTimeEntryInProgressParam{Workspace: "w", UserID: "u"}
+	m.EXPECT().GetTimeEntryInProgress(pp).Return(nil, nil).Once()
+	m.EXPECT().CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: "w", Start: start, Description: "Writing",
+	}).Return(dto.TimeEntryImpl{ID: "remote", WorkspaceID: "w"}, nil).Once()
+
+	up.TimeEntryID = "remote"
+	m.EXPECT().UpdateTimeEntry(up).
+		Return(dto.TimeEntryImpl{ID: "remote", WorkspaceID: "w"}, nil).Once()
+
+	rs, err := j.Replay(c, journal.ReplayOptions{UserID: "u"})
+	assert.NoError(t, err)
+	if assert.Len(t, rs, 2) {
+		assert.Equal(t, "remote", rs[0].TimeEntryID)
+		assert.Equal(t, journal.StatusApplied, rs[0].Status)
+		assert.Equal(t, "remote", rs[1].TimeEntryID)
+	}
+
+	ops, err := j.Pending()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 0)
+
+	// the local id is still known after the journal is compacted
+	id, ok, err := j.RemoteID(created.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "remote", id)
+
+	m.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
+		Workspace: "w", TimeEntryID: "remote",
+	}).Return(&dto.TimeEntryImpl{ID: "remote"}, nil).Once()
+	te, err := c.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace: "w", TimeEntryID: created.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "remote", te.ID)
+
+	// and new local ids don't reuse the ones already synced
+	m.EXPECT().CreateTimeEntry(mock.Anything).
+		Return(dto.TimeEntryImpl{}, errUnreachable).Once()
+	other, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: "w", Start: end, Description: "Reading",
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, created.ID, other.ID)
+}
+
+func TestReplayConflicts(t *testing.T) {
+	base := dto.TimeEntryImpl{
+		ID:          "te1",
+		WorkspaceID: "w",
+		UserID:      "u",
+		Description: "Reading",
+		TimeInterval: dto.NewTimeInterval(
+			time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC), nil),
+	}
+
+	edited := base
+	edited.Description = "Edited on the site"
+
+	record := func(t *testing.T) (*journal.Journal, *mocks.MockClient) {
+		j := newJournal(t)
+		m := mocks.NewMockClient(t)
+		c := journal.NewClient(m, j, &bytes.Buffer{})
+
+		gp := api.GetTimeEntryParam{Workspace: "w", TimeEntryID: "te1"}
+		m.EXPECT().GetTimeEntry(gp).Return(&base, nil).Once()
+		_, err := c.GetTimeEntry(gp)
+		assert.NoError(t, err)
+
+		m.EXPECT().DeleteTimeEntry(mock.Anything).
+			Return(errUnreachable).Once()
+		assert.NoError(t, c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+			Workspace: "w", TimeEntryID: "te1",
+		}))
+
+		m.EXPECT().GetTimeEntry(gp).Return(&edited, nil).Once()
+		return j, m
+	}
+
+	t.Run("stops on conflict", func(t *testing.T) {
+		j, m := record(t)
+
+		rs, err := j.Replay(m, journal.ReplayOptions{UserID: "u"})
+		assert.Len(t, rs, 0)
+		assert.ErrorAs(t, err, &journal.ConflictError{})
+		assert.Regexp(t, "time entry te1 was changed remotely", err.Error())
+
+		ops, _ := j.Pending()
+		assert.Len(t, ops, 1)
+	})
+
+	t.Run("discard conflicts", func(t *testing.T) {
+		j, m := record(t)
+
+		rs, err := j.Replay(m, journal.ReplayOptions{
+			UserID:           "u",
+			DiscardConflicts: true,
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, rs, 1) {
+			assert.Equal(t, journal.StatusDiscarded, rs[0].Status)
+		}
+
+		ops, _ := j.Pending()
+		assert.Len(t, ops, 0)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		j, m := record(t)
+		m.EXPECT().DeleteTimeEntry(api.DeleteTimeEntryParam{
+			Workspace: "w", TimeEntryID: "te1",
+		}).Return(nil).Once()
+
+		rs, err := j.Replay(m, journal.ReplayOptions{UserID: "u", Force: true})
+		assert.NoError(t, err)
+		if assert.Len(t, rs, 1) {
+			assert.Equal(t, journal.StatusApplied, rs[0].Status)
+		}
+	})
+}
+
+func TestReplayOverlappingTimers(t *testing.T) {
+	j := newJournal(t)
+	m := mocks.NewMockClient(t)
+	c := journal.NewClient(m, j, &bytes.Buffer{})
+
+	start := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	m.EXPECT().CreateTimeEntry(mock.Anything).
+		Return(dto.TimeEntryImpl{}, errUnreachable).Once()
+	_, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: "w", Start: start,
+	})
+	assert.NoError(t, err)
+
+	m.EXPECT().GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+		Workspace: "w", UserID: "u",
+	}).Return(&dto.TimeEntryImpl{
+		ID:           "other",
+		TimeInterval: dto.NewTimeInterval(start.Add(time.Minute), nil),
+	}, nil).Once()
+
+	_, err = j.Replay(c, journal.ReplayOptions{UserID: "u"})
+	assert.Error(t, err)
+	assert.Regexp(t, "time entry other is running since", err.Error())
+}
@@ -0,0 +1,333 @@
+package journal
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// ConflictError is returned when a operation can't be applied because the
+// time entry was changed remotely since it was recorded
+type ConflictError struct {
+	Operation Operation
+	Reason    string
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("conflict on #%d (%s): %s",
+		e.Operation.Seq, e.Operation.Description(), e.Reason)
+}
+
+// Status is what happened with a operation while replaying the journal
+type Status string
+
+const (
+	StatusApplied   Status = "applied"
+	StatusDiscarded Status = "discarded"
+)
+
+// Result of the replay of a operation
+type Result struct {
+	Operation   Operation
+	Status      Status
+	TimeEntryID string
+	Reason      string
+}
+
+// ReplayOptions changes how conflicts are handled while replaying
+type ReplayOptions struct {
+	// UserID is the user running the replay, used to look for running time
+	// entries
+	UserID string
+	// Force applies operations even when conflicts are detected
+	Force bool
+	// DiscardConflicts drops the operations with conflicts, instead of
+	// stopping the replay
+	DiscardConflicts bool
+}
+
+// Replay sends the pending operations to the API in the order they were
+// recorded, stopping at the first failure or conflict. The results of the
+// operations resolved are always returned, even with an error.
+func (j *Journal) Replay(c api.Client, o ReplayOptions) ([]Result, error) {
+	ops, err := j.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	j.setReplaying(true)
+	defer j.setReplaying(false)
+
+	rs := make([]Result, 0, len(ops))
+	for _, op := range ops {
+		r, err := j.replay(c, op, o)
+		if err != nil {
+			var ce ConflictError
+			if !errors.As(err, &ce) || !o.DiscardConflicts {
+				return rs, err
+			}
+
+			r = Result{
+				Operation:   op,
+				Status:      StatusDiscarded,
+				TimeEntryID: op.TimeEntryID,
+				Reason:      ce.Reason,
+			}
+		}
+
+		res := resolution{Seq: op.Seq, Discarded: r.Status == StatusDiscarded}
+		if op.Kind == KindCreate && r.Status == StatusApplied {
+			res.TimeEntryID = r.TimeEntryID
+		}
+
+		if err := j.resolve(res); err != nil {
+			return rs, err
+		}
+
+		rs = append(rs, r)
+	}
+
+	return rs, nil
+}
+
+func (j *Journal) replay(
+	c api.Client, op Operation, o ReplayOptions) (Result, error) {
+	r := Result{Operation: op, Status: StatusApplied}
+
+	conflict := func(format string, args ...interface{}) error {
+		return ConflictError{
+			Operation: op,
+			Reason:    fmt.Sprintf(format, args...),
+		}
+	}
+
+	switch op.Kind {
+	case KindCreate:
+		p := *op.Create
+		if p.End == nil && !o.Force {
+			te, err := c.GetTimeEntryInProgress(
+				api.GetTimeEntryInProgressParam{
+					Workspace: op.Workspace,
+					UserID:    o.UserID,
+				})
+			if err != nil {
+				return r, err
+			}
+
+			if te != nil {
+				return r, conflict(
+					"time entry %s is running since %s, "+
+						"it would overlap with the one started at %s",
+					te.ID,
+					te.TimeInterval.Start.Local().Format(time.DateTime),
+					p.Start.Local().Format(time.DateTime),
+				)
+			}
+		}
+
+		te, err := c.CreateTimeEntry(p)
+		if err != nil {
+			return r, err
+		}
+
+		r.TimeEntryID = te.ID
+		if err = j.Remember(te); err != nil {
+			return r, err
+		}
+	case KindOut:
+		p := *op.Out
+		expected, _, err := j.resolveIDs(op.TimeEntryID, conflict)
+		if err != nil {
+			return r, err
+		}
+
+		te, err := c.GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+			Workspace: op.Workspace,
+			UserID:    p.UserID,
+		})
+		if err != nil {
+			return r, err
+		}
+
+		if te == nil {
+			if expected != "" && !o.Force {
+				return r, conflict(
+					"time entry %s was already stopped remotely", expected)
+			}
+
+			r.Reason = "no time entry running"
+			return r, nil
+		}
+
+		r.TimeEntryID = te.ID
+		if !o.Force {
+			if expected != "" && te.ID != expected {
+				return r, conflict(
+					"time entry %s is running instead of %s",
+					te.ID, expected)
+			}
+
+			if te.TimeInterval.Start.After(p.End) {
+				return r, conflict(
+					"time entry %s started at %s, after %s",
+					te.ID,
+					te.TimeInterval.Start.Local().Format(time.DateTime),
+					p.End.Local().Format(time.DateTime),
+				)
+			}
+		}
+
+		if err := c.Out(p); err != nil {
+			return r, err
+		}
+
+		if err := j.ForgetRunning(p.Workspace, p.UserID); err != nil {
+			return r, err
+		}
+	case KindUpdate:
+		p := *op.Update
+		id, local, err := j.resolveIDs(p.TimeEntryID, conflict)
+		if err != nil {
+			return r, err
+		}
+
+		p.TimeEntryID = id
+		r.TimeEntryID = id
+		if !local {
+			err := checkRemote(c, op, id, conflict)
+			if errors.As(err, &api.EntityNotFound{}) {
+				return r, conflict("time entry %s was deleted remotely", id)
+			}
+
+			if err != nil && !(o.Force && errors.As(err, &ConflictError{})) {
+				return r, err
+			}
+		}
+
+		te, err := c.UpdateTimeEntry(p)
+		if err != nil {
+			return r, err
+		}
+
+		if err = j.Remember(te); err != nil {
+			return r, err
+		}
+	case KindDelete:
+		p := *op.Delete
+		id, local, err := j.resolveIDs(p.TimeEntryID, conflict)
+		if err != nil {
+			return r, err
+		}
+
+		p.TimeEntryID = id
+		r.TimeEntryID = id
+		if !local {
+			err := checkRemote(c, op, id, conflict)
+			if errors.As(err, &api.EntityNotFound{}) {
+				r.Reason = "already deleted"
+				return r, nil
+			}
+
+			if err != nil && !(o.Force && errors.As(err, &ConflictError{})) {
+				return r, err
+			}
+		}
+
+		if err := c.DeleteTimeEntry(p); err != nil {
+			return r, err
+		}
+
+		if err = j.Forget(id); err != nil {
+			return r, err
+		}
+	}
+
+	return r, nil
+}
+
+// resolveIDs returns the remote id of a time entry, and if it was created by
+// the journal
+func (j *Journal) resolveIDs(
+	id string, conflict func(string, ...interface{}) error,
+) (string, bool, error) {
+	if !IsLocalID(id) {
+		return id, false, nil
+	}
+
+	rid, ok, err := j.RemoteID(id)
+	if err != nil {
+		return id, true, err
+	}
+
+	if !ok {
+		return id, true, conflict(
+			"time entry %s was not created on the API", id)
+	}
+
+	return rid, true, nil
+}
+
+// checkRemote looks if the time entry was changed since the operation was
+// recorded
+func checkRemote(
+	c api.Client, op Operation, id string,
+	conflict func(string, ...interface{}) error,
+) error {
+	te, err := c.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace:   op.Workspace,
+		TimeEntryID: id,
+	})
+	if err != nil {
+		var apiErr dto.Error
+		if errors.As(err, &apiErr) && apiErr.Code == 404 {
+			return api.EntityNotFound{EntityName: "time entry", ID: id}
+		}
+
+		return err
+	}
+
+	if te == nil {
+		return api.EntityNotFound{EntityName: "time entry", ID: id}
+	}
+
+	if op.Base != nil && !equal(*op.Base, *te) {
+		return conflict("time entry %s was changed remotely", id)
+	}
+
+	return nil
+}
+
+// equal compares the fields of the time entries that can be changed by the
+// user
+func equal(a, b dto.TimeEntryImpl) bool {
+	if a.Description != b.Description || a.ProjectID != b.ProjectID ||
+		a.TaskID != b.TaskID || a.Billable != b.Billable ||
+		!a.TimeInterval.Start.Equal(b.TimeInterval.Start) {
+		return false
+	}
+
+	ae, be := a.TimeInterval.End, b.TimeInterval.End
+	if (ae == nil) != (be == nil) || (ae != nil && !ae.Equal(*be)) {
+		return false
+	}
+
+	if len(a.TagIDs) != len(b.TagIDs) {
+		return false
+	}
+
+	at := append([]string{}, a.TagIDs...)
+	bt := append([]string{}, b.TagIDs...)
+	sort.Strings(at)
+	sort.Strings(bt)
+	for i := range at {
+		if at[i] != bt[i] {
+			return false
+		}
+	}
+
+	return true
+}
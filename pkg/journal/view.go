@@ -0,0 +1,127 @@
+package journal
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// View returns how the time entries of the workspace are expected to be after
+// all pending operations are synced, based on the last known state of them
+func (j *Journal) View(workspace string) (map[string]dto.TimeEntryImpl, error) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	k, err := j.readKnown()
+	if err != nil {
+		return k, err
+	}
+
+	s, err := j.read()
+	if err != nil {
+		return k, err
+	}
+
+	v := make(map[string]dto.TimeEntryImpl, len(k))
+	for id, t := range k {
+		if t.WorkspaceID == workspace {
+			v[id] = t
+		}
+	}
+
+	for _, o := range s.pending {
+		if o.Workspace == workspace {
+			apply(v, o)
+		}
+	}
+
+	return v, nil
+}
+
+func interval(start time.Time, end *time.Time) dto.TimeInterval {
+	if end != nil {
+		e := *end
+		end = &e
+	}
+
+	return dto.NewTimeInterval(start, end)
+}
+
+func apply(v map[string]dto.TimeEntryImpl, o Operation) {
+	switch o.Kind {
+	case KindCreate:
+		p := o.Create
+		t := dto.TimeEntryImpl{
+			ID:           o.TimeEntryID,
+			WorkspaceID:  o.Workspace,
+			Description:  p.Description,
+			ProjectID:    p.ProjectID,
+			TaskID:       p.TaskID,
+			TagIDs:       p.TagIDs,
+			TimeInterval: interval(p.Start, p.End),
+		}
+
+		if p.Billable != nil {
+			t.Billable = *p.Billable
+		}
+
+		v[t.ID] = t
+	case KindOut:
+		for _, t := range running(v, o.Out.UserID) {
+			t.TimeInterval = interval(t.TimeInterval.Start, &o.Out.End)
+			v[t.ID] = t
+		}
+	case KindUpdate:
+		p := o.Update
+		t := v[p.TimeEntryID]
+		t.ID = p.TimeEntryID
+		t.WorkspaceID = o.Workspace
+		t.Description = p.Description
+		t.ProjectID = p.ProjectID
+		t.TaskID = p.TaskID
+		t.TagIDs = p.TagIDs
+		t.Billable = p.Billable
+		t.TimeInterval = interval(p.Start, p.End)
+		v[t.ID] = t
+	case KindDelete:
+		delete(v, o.Delete.TimeEntryID)
+	}
+}
+
+// running returns the time entries without end of the user, most recent
+// first; time entries created offline don't have a user and are considered
+// from the user
+func running(
+	v map[string]dto.TimeEntryImpl, userID string) []dto.TimeEntryImpl {
+	tes := make([]dto.TimeEntryImpl, 0)
+	for _, t := range v {
+		if t.TimeInterval.End == nil &&
+			(t.UserID == "" || t.UserID == userID) {
+			tes = append(tes, t)
+		}
+	}
+
+	sort.Slice(tes, func(i, k int) bool {
+		return tes[i].TimeInterval.Start.After(tes[k].TimeInterval.Start)
+	})
+
+	return tes
+}
+
+// Running returns the time entry expected to be running for the user after
+// all pending operations are synced
+func (j *Journal) Running(workspace, userID string) (
+	*dto.TimeEntryImpl, error) {
+	v, err := j.View(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	tes := running(v, userID)
+	if len(tes) == 0 {
+		return nil, nil
+	}
+
+	return &tes[0], nil
+}
@@ -0,0 +1,150 @@
+package invoice
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+)
+
+// Item is a line of the invoice, with the time spent on a project and task
+// with the same hourly rate
+type Item struct {
+	ProjectID   string
+	ProjectName string
+	TaskID      string
+	TaskName    string
+
+	Rate     dto.Rate
+	Duration time.Duration
+	// Amount in cents of the rate currency
+	Amount int64
+
+	TimeEntries []dto.TimeEntry
+}
+
+// Hours returns the duration of the item as hours
+func (i Item) Hours() float64 {
+	return i.Duration.Hours()
+}
+
+// Total is the sum of the items of a currency
+type Total struct {
+	Currency string
+	Duration time.Duration
+	// Amount in cents of the currency
+	Amount int64
+}
+
+// Invoice is the data used to render a invoice document
+type Invoice struct {
+	Number string
+	// Date the invoice was issued
+	Date time.Time
+	// Start and End of the period invoiced
+	Start time.Time
+	End   time.Time
+
+	Workspace dto.Workspace
+	Client    dto.Client
+
+	Items  []Item
+	Totals []Total
+
+	TimeEntries []dto.TimeEntry
+}
+
+// Options sets how the invoice is built
+type Options struct {
+	Number    string
+	Date      time.Time
+	Start     time.Time
+	End       time.Time
+	Workspace dto.Workspace
+	Client    dto.Client
+	// Rates are used to find the hourly rate of each time entry
+	Rates ratehlp.Rates
+}
+
+// New creates a invoice for the time entries, grouping them by project, task
+// and hourly rate. The time entries must be finished
+func New(tes []dto.TimeEntry, o Options) Invoice {
+	inv := Invoice{
+		Number:      o.Number,
+		Date:        o.Date,
+		Start:       o.Start,
+		End:         o.End,
+		Workspace:   o.Workspace,
+		Client:      o.Client,
+		Items:       make([]Item, 0),
+		Totals:      make([]Total, 0),
+		TimeEntries: tes,
+	}
+
+	items := map[string]int{}
+	totals := map[string]int{}
+	for _, te := range tes {
+		i := Item{Rate: o.Rates.HourlyRate(te)}
+		if te.Project != nil {
+			i.ProjectID = te.Project.ID
+			i.ProjectName = te.Project.Name
+		} else {
+			i.ProjectID = te.ProjectID
+		}
+
+		if te.Task != nil {
+			i.TaskID = te.Task.ID
+			i.TaskName = te.Task.Name
+		}
+
+		key := strings.Join([]string{
+			i.ProjectID, i.TaskID, i.Rate.Currency,
+			strconv.FormatInt(i.Rate.Amount, 10),
+		}, "/")
+		k, ok := items[key]
+		if !ok {
+			k = len(inv.Items)
+			items[key] = k
+			inv.Items = append(inv.Items, i)
+		}
+
+		e := o.Rates.Earning(te, o.Date)
+		d := te.TimeInterval.End.Sub(te.TimeInterval.Start)
+		inv.Items[k].Duration += d
+		inv.Items[k].Amount += e.Earned
+		inv.Items[k].TimeEntries = append(inv.Items[k].TimeEntries, te)
+
+		t, ok := totals[i.Rate.Currency]
+		if !ok {
+			t = len(inv.Totals)
+			totals[i.Rate.Currency] = t
+			inv.Totals = append(inv.Totals, Total{Currency: i.Rate.Currency})
+		}
+
+		inv.Totals[t].Duration += d
+		inv.Totals[t].Amount += e.Earned
+	}
+
+	sort.SliceStable(inv.Items, func(i, j int) bool {
+		a, b := inv.Items[i], inv.Items[j]
+		if a.ProjectName != b.ProjectName {
+			return strings.ToLower(a.ProjectName) <
+				strings.ToLower(b.ProjectName)
+		}
+
+		if a.TaskName != b.TaskName {
+			return strings.ToLower(a.TaskName) < strings.ToLower(b.TaskName)
+		}
+
+		return a.Rate.Amount > b.Rate.Amount
+	})
+
+	sort.Slice(inv.Totals, func(i, j int) bool {
+		return inv.Totals[i].Currency < inv.Totals[j].Currency
+	})
+
+	return inv
+}
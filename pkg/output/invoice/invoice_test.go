@@ -0,0 +1,148 @@
+package invoice_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/invoice"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func invoiceFixture() invoice.Invoice {
+	rate := func(a int64, c string) *dto.Rate {
+		return &dto.Rate{Amount: a, Currency: c}
+	}
+
+	ws := dto.Workspace{ID: "w", Name: "Mine", HourlyRate: *rate(4000, "USD")}
+	ps := []dto.Project{
+		{
+			ID:         "p1",
+			Name:       "Website",
+			HourlyRate: *rate(5000, "USD"),
+			Tasks: []dto.Task{
+				{ID: "t1", Name: "Layout", HourlyRate: rate(6000, "USD")},
+			},
+		},
+		{ID: "p2", Name: "App <beta>", HourlyRate: *rate(10000, "BRL")},
+	}
+
+	start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+	te := func(id, p, t string, d time.Duration) dto.TimeEntry {
+		end := start.Add(d)
+		te := dto.TimeEntry{
+			ID:           id,
+			Billable:     true,
+			ProjectID:    p,
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		}
+		for i := range ps {
+			if ps[i].ID == p {
+				te.Project = &ps[i]
+			}
+		}
+		if t != "" {
+			te.Task = &dto.Task{ID: t, Name: "Layout"}
+		}
+		return te
+	}
+
+	return invoice.New([]dto.TimeEntry{
+		te("te1", "p1", "", time.Hour),
+		te("te2", "p2", "", 30*time.Minute),
+		te("te3", "p1", "t1", 90*time.Minute),
+		te("te4", "p1", "", 2*time.Hour),
+	}, invoice.Options{
+		Number:    "42",
+		Date:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Start:     time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:       time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC),
+		Workspace: ws,
+		Client:    dto.Client{ID: "c1", Name: "Acme & Co"},
+		Rates:     ratehlp.New("u", ws, ps),
+	})
+}
+
+func TestNew(t *testing.T) {
+	inv := invoiceFixture()
+
+	if !assert.Len(t, inv.Items, 3) {
+		return
+	}
+
+	assert.Equal(t, "App <beta>", inv.Items[0].ProjectName)
+	assert.Equal(t, int64(5000), inv.Items[0].Amount)
+
+	assert.Equal(t, "", inv.Items[1].TaskName)
+	assert.Equal(t, 3*time.Hour, inv.Items[1].Duration)
+	assert.Equal(t, int64(15000), inv.Items[1].Amount)
+	assert.Len(t, inv.Items[1].TimeEntries, 2)
+
+	assert.Equal(t, "Layout", inv.Items[2].TaskName)
+	assert.Equal(t, int64(6000), inv.Items[2].Rate.Amount)
+	assert.Equal(t, int64(9000), inv.Items[2].Amount)
+
+	assert.Equal(t, []invoice.Total{
+		{Currency: "BRL", Duration: 30 * time.Minute, Amount: 5000},
+		{Currency: "USD", Duration: 270 * time.Minute, Amount: 24000},
+	}, inv.Totals)
+}
+
+func TestInvoicePrint(t *testing.T) {
+	inv := invoiceFixture()
+
+	t.Run("markdown", func(t *testing.T) {
+		b := &strings.Builder{}
+		err := invoice.InvoicePrint(
+			inv, invoice.FormatMarkdown, "", language.English, b)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, "# Invoice 42\n\n"+
+			"**Date:** 2026-10-01  \n"+
+			"**Period:** 2026-09-01 to 2026-09-30\n\n"+
+			"**From:** Mine  \n"+
+			"**To:** Acme & Co\n\n"+
+			heredoc.Doc(`
+				| Project | Task | Hours | Rate | Amount |
+				|---------|------|------:|-----:|-------:|
+				| App <beta> | - | 0.50 | BRL 100.00 | BRL 50.00 |
+				| Website | - | 3.00 | USD 50.00 | USD 150.00 |
+				| Website | Layout | 1.50 | USD 60.00 | USD 90.00 |
+				| **Total** | | **0.50** | | **BRL 50.00** |
+				| **Total** | | **4.50** | | **USD 240.00** |
+			`), b.String())
+	})
+
+	t.Run("html escapes values", func(t *testing.T) {
+		for _, f := range []invoice.Format{
+			invoice.FormatHTML, invoice.FormatPrintable} {
+			b := &strings.Builder{}
+			err := invoice.InvoicePrint(inv, f, "", language.English, b)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Contains(t, b.String(), "App &lt;beta&gt;")
+			assert.Contains(t, b.String(), "Acme &amp; Co")
+			assert.Contains(t, b.String(), "USD 240.00")
+		}
+	})
+
+	t.Run("custom template", func(t *testing.T) {
+		b := &strings.Builder{}
+		err := invoice.InvoicePrint(inv, invoice.FormatMarkdown,
+			`{{ .Client.Name }}:{{ range .Items }} {{ hours .Duration }}{{ end }}`,
+			language.English, b)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, "Acme & Co: 0.50 3.00 1.50", b.String())
+	})
+}
@@ -0,0 +1,89 @@
+package invoice
+
+import (
+	_ "embed"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Format of the invoice document
+type Format string
+
+const (
+	FormatMarkdown  Format = "markdown"
+	FormatHTML      Format = "html"
+	FormatPrintable Format = "printable"
+)
+
+var (
+	//go:embed invoice.md.gotmpl
+	mdTemplate string
+	//go:embed invoice.html.gotmpl
+	htmlTemplate string
+	//go:embed invoice.printable.gotmpl
+	printableTemplate string
+)
+
+func funcMap(l language.Tag) map[string]interface{} {
+	p := message.NewPrinter(l)
+	return map[string]interface{}{
+		"money": func(cents int64, currency string) string {
+			return ratehlp.FormatAmount(p, currency, cents)
+		},
+		"rate": func(r dto.Rate) string {
+			return ratehlp.FormatAmount(p, r.Currency, r.Amount)
+		},
+		"hours": func(d time.Duration) string {
+			return p.Sprint(number.Decimal(d.Hours(), number.Scale(2)))
+		},
+		"duration": func(d time.Duration) string {
+			return dto.Duration{Duration: d}.HumanString()
+		},
+		"date": func(t time.Time) string {
+			return t.Format("2006-01-02")
+		},
+	}
+}
+
+// InvoicePrint renders the invoice using tmpl, or the default template of
+// the format when it is empty. Markdown templates are parsed with
+// text/template and the others with html/template, which escapes the values
+func InvoicePrint(
+	inv Invoice, f Format, tmpl string, l language.Tag, w io.Writer,
+) error {
+	fs := funcMap(l)
+	if f == FormatMarkdown {
+		if tmpl == "" {
+			tmpl = mdTemplate
+		}
+
+		t, err := template.New("invoice").Funcs(fs).Parse(tmpl)
+		if err != nil {
+			return err
+		}
+
+		return t.Execute(w, inv)
+	}
+
+	if tmpl == "" {
+		tmpl = htmlTemplate
+		if f == FormatPrintable {
+			tmpl = printableTemplate
+		}
+	}
+
+	t, err := htmltemplate.New("invoice").Funcs(fs).Parse(tmpl)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, inv)
+}
@@ -0,0 +1,81 @@
+package journal
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/olekukonko/tablewriter"
+)
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return timehlp.NowTimeFormat
+	}
+
+	return t.Local().Format(timehlp.FullTimeFormat)
+}
+
+// details summarizes what the operation will change
+func details(o journal.Operation) string {
+	switch o.Kind {
+	case journal.KindCreate:
+		return formatTime(&o.Create.Start) + " until " +
+			formatTime(o.Create.End) + ": " + o.Create.Description
+	case journal.KindOut:
+		return "stop at " + formatTime(&o.Out.End)
+	case journal.KindUpdate:
+		return formatTime(&o.Update.Start) + " until " +
+			formatTime(o.Update.End) + ": " + o.Update.Description
+	default:
+		return ""
+	}
+}
+
+// OperationsPrint will print the pending operations as a table
+func OperationsPrint(ops []journal.Operation, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{
+		"#", "Recorded At", "Operation", "Time Entry", "Details"})
+
+	lines := make([][]string, len(ops))
+	for i, o := range ops {
+		lines[i] = []string{
+			strconv.Itoa(o.Seq),
+			formatTime(&o.RecordedAt),
+			o.Description(),
+			o.TimeEntryID,
+			details(o),
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+// ResultsPrint will print what happened with each synced operation as a
+// table
+func ResultsPrint(rs []journal.Result, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"#", "Operation", "Status", "Time Entry", "Reason"})
+
+	lines := make([][]string, len(rs))
+	for i, r := range rs {
+		lines[i] = []string{
+			strconv.Itoa(r.Operation.Seq),
+			r.Operation.Description(),
+			string(r.Status),
+			r.TimeEntryID,
+			r.Reason,
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package journal
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+)
+
+// OperationsJSONPrint will print the pending operations as JSON
+func OperationsJSONPrint(ops []journal.Operation, w io.Writer) error {
+	return json.NewEncoder(w).Encode(ops)
+}
+
+// ResultsJSONPrint will print the results of the sync as JSON
+func ResultsJSONPrint(rs []journal.Result, w io.Writer) error {
+	return json.NewEncoder(w).Encode(rs)
+}
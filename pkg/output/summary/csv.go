@@ -0,0 +1,58 @@
+package summary
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// SummaryCSVPrint prints the summary as CSV, with a line for each group and
+// its parent groups filled. The lines of the parent groups have the columns
+// of the next dimensions empty, and the last line has the totals
+func SummaryCSVPrint(s Summary, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	header := make([]string, 0, len(s.GroupBy)*2+3)
+	for _, d := range s.GroupBy {
+		header = append(header, d+".key", d+".name")
+	}
+
+	if err := w.Write(append(header,
+		"duration", "billableDuration", "amount")); err != nil {
+		return err
+	}
+
+	var writeGroups func(gs []Group, parent []string) error
+	writeGroups = func(gs []Group, parent []string) error {
+		for _, g := range gs {
+			line := make([]string, len(s.GroupBy)*2, len(s.GroupBy)*2+3)
+			copy(line, parent)
+			line[len(parent)] = g.Key
+			line[len(parent)+1] = g.Name
+
+			if err := w.Write(
+				append(line, totalsToLine(g.Totals)...)); err != nil {
+				return err
+			}
+
+			if err := writeGroups(
+				g.Groups, line[:len(parent)+2]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := writeGroups(s.Groups, []string{}); err != nil {
+		return err
+	}
+
+	line := make([]string, len(s.GroupBy)*2, len(s.GroupBy)*2+3)
+	line[1] = "total"
+	if err := w.Write(append(line, totalsToLine(s.Totals)...)); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
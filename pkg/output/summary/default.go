@@ -0,0 +1,79 @@
+package summary
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/olekukonko/tablewriter"
+)
+
+func durationToString(d time.Duration) string {
+	return dto.Duration{Duration: d}.HumanString()
+}
+
+// amountsToString shows the amounts of each currency, like "USD 10.50"
+func amountsToString(as []Amount) string {
+	s := make([]string, len(as))
+	for i, a := range as {
+		s[i] = strings.TrimSpace(fmt.Sprintf("%s %.2f",
+			a.Currency, float64(a.Value)/100))
+	}
+
+	return strings.Join(s, ", ")
+}
+
+func totalsToLine(t Totals) []string {
+	return []string{
+		durationToString(t.Duration),
+		durationToString(t.BillableDuration),
+		amountsToString(t.Amounts),
+	}
+}
+
+// SummaryPrint prints the summary as a table, each group is followed by its
+// sub groups on the next column
+func SummaryPrint(s Summary, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetAutoFormatHeaders(false)
+
+	header := make([]string, len(s.GroupBy), len(s.GroupBy)+3)
+	for i, d := range s.GroupBy {
+		header[i] = strings.ToUpper(d[:1]) + d[1:]
+	}
+	tw.SetHeader(append(header, "Duration", "Billable", "Amount"))
+
+	align := make([]int, len(s.GroupBy)+3)
+	for i := range align {
+		align[i] = tablewriter.ALIGN_RIGHT
+		if i < len(s.GroupBy) {
+			align[i] = tablewriter.ALIGN_LEFT
+		}
+	}
+	tw.SetColumnAlignment(align)
+
+	var appendGroups func(gs []Group, level int)
+	appendGroups = func(gs []Group, level int) {
+		for _, g := range gs {
+			line := make([]string, len(s.GroupBy), len(s.GroupBy)+3)
+			line[level] = g.Name
+			tw.Append(append(line, totalsToLine(g.Totals)...))
+			appendGroups(g.Groups, level+1)
+		}
+	}
+	appendGroups(s.Groups, 0)
+
+	// empty cells on the footer would hide its borders
+	footer := make([]string, len(s.GroupBy), len(s.GroupBy)+3)
+	for i := range footer {
+		footer[i] = " "
+	}
+	footer[0] = "Total"
+	tw.SetFooter(append(footer, totalsToLine(s.Totals)...))
+	tw.SetFooterAlignment(tablewriter.ALIGN_RIGHT)
+
+	tw.Render()
+	return nil
+}
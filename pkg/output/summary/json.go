@@ -0,0 +1,75 @@
+package summary
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type jsonAmount struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+type jsonTotals struct {
+	Duration         dto.Duration `json:"duration"`
+	BillableDuration dto.Duration `json:"billableDuration"`
+	Amounts          []jsonAmount `json:"amounts"`
+}
+
+type jsonGroup struct {
+	Dimension string `json:"dimension"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	jsonTotals
+	Groups []jsonGroup `json:"groups,omitempty"`
+}
+
+type jsonSummary struct {
+	GroupBy []string    `json:"groupBy"`
+	Groups  []jsonGroup `json:"groups"`
+	jsonTotals
+}
+
+func toJSONTotals(t Totals) jsonTotals {
+	j := jsonTotals{
+		Duration:         dto.Duration{Duration: t.Duration},
+		BillableDuration: dto.Duration{Duration: t.BillableDuration},
+		Amounts:          make([]jsonAmount, len(t.Amounts)),
+	}
+
+	for i, a := range t.Amounts {
+		j.Amounts[i] = jsonAmount{
+			Currency: a.Currency,
+			Amount:   float64(a.Value) / 100,
+		}
+	}
+
+	return j
+}
+
+func toJSONGroups(gs []Group) []jsonGroup {
+	j := make([]jsonGroup, len(gs))
+	for i, g := range gs {
+		j[i] = jsonGroup{
+			Dimension:  g.Dimension,
+			Key:        g.Key,
+			Name:       g.Name,
+			jsonTotals: toJSONTotals(g.Totals),
+			Groups:     toJSONGroups(g.Groups),
+		}
+	}
+
+	return j
+}
+
+// SummaryJSONPrint prints the summary as JSON, with the sub groups nested
+// into their groups
+func SummaryJSONPrint(s Summary, w io.Writer) error {
+	return json.NewEncoder(w).Encode(jsonSummary{
+		GroupBy:    s.GroupBy,
+		Groups:     toJSONGroups(s.Groups),
+		jsonTotals: toJSONTotals(s.Totals),
+	})
+}
@@ -0,0 +1,318 @@
+package summary
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// Dimensions the time entries can be grouped by
+const (
+	DimensionProject     = "project"
+	DimensionClient      = "client"
+	DimensionTask        = "task"
+	DimensionTag         = "tag"
+	DimensionDescription = "description"
+	DimensionDay         = "day"
+	DimensionWeek        = "week"
+	DimensionMonth       = "month"
+	DimensionBillable    = "billable"
+)
+
+// Dimensions lists all the dimensions that can be used to group the time
+// entries
+var Dimensions = []string{
+	DimensionProject,
+	DimensionClient,
+	DimensionTask,
+	DimensionTag,
+	DimensionDescription,
+	DimensionDay,
+	DimensionWeek,
+	DimensionMonth,
+	DimensionBillable,
+}
+
+// CheckDimensions validates that the dimensions exist and are not repeated
+func CheckDimensions(ds []string) error {
+	if len(ds) == 0 {
+		return errors.New("at least one dimension must be informed")
+	}
+
+	seen := map[string]bool{}
+	for _, d := range ds {
+		if seen[d] {
+			return errors.New("dimension \"" + d + "\" is repeated")
+		}
+		seen[d] = true
+
+		valid := false
+		for _, v := range Dimensions {
+			if v == d {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return errors.New("dimension \"" + d + "\" is not valid, " +
+				"use one of: " + strings.Join(Dimensions, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Amount is a sum of billable amounts in a currency, in cents
+type Amount struct {
+	Currency string
+	Value    int64
+}
+
+// Totals are the sums of the time entries of a group
+type Totals struct {
+	Duration         time.Duration
+	BillableDuration time.Duration
+	// Amounts of the billable time entries by currency, sorted by currency
+	Amounts []Amount
+}
+
+func (t *Totals) add(d time.Duration, billable bool, r dto.Rate) {
+	t.Duration += d
+	if !billable {
+		return
+	}
+
+	t.BillableDuration += d
+	if r.Amount == 0 {
+		return
+	}
+
+	v := ratehlp.Amount(r, d)
+	for i := range t.Amounts {
+		if t.Amounts[i].Currency == r.Currency {
+			t.Amounts[i].Value += v
+			return
+		}
+	}
+
+	t.Amounts = append(t.Amounts, Amount{Currency: r.Currency, Value: v})
+	sort.Slice(t.Amounts, func(i, j int) bool {
+		return t.Amounts[i].Currency < t.Amounts[j].Currency
+	})
+}
+
+// Group is the sum of the time entries with the same value for a dimension,
+// with sub groups for the next dimensions
+type Group struct {
+	Dimension string
+	// Key identifies the group, it is a id for projects, clients, tasks and
+	// tags, or the value itself for the other dimensions
+	Key  string
+	Name string
+	Totals
+
+	Groups []Group
+}
+
+// Summary is the time entries grouped by the dimensions
+type Summary struct {
+	GroupBy []string
+	Groups  []Group
+	Totals
+}
+
+// Options sets how the summary is built
+type Options struct {
+	// GroupBy are the dimensions to group the time entries, in order
+	GroupBy []string
+	// Location is the time zone used to decide on which day a time entry was
+	// made
+	Location *time.Location
+	// Now is used as the end of running time entries
+	Now time.Time
+}
+
+// value is a key and name of a dimension of a time entry
+type value struct {
+	key  string
+	name string
+}
+
+const (
+	noProject     = "(no project)"
+	noClient      = "(no client)"
+	noTask        = "(no task)"
+	noTag         = "(no tag)"
+	noDescription = "(no description)"
+)
+
+// values returns the values of the dimension for the time entry, a time
+// entry has multiple values only for tags
+func values(te dto.TimeEntry, d string, l *time.Location) []value {
+	start := te.TimeInterval.Start.In(l)
+	switch d {
+	case DimensionProject:
+		if te.Project != nil {
+			return []value{{te.Project.ID, te.Project.Name}}
+		}
+
+		if te.ProjectID != "" {
+			return []value{{te.ProjectID, te.ProjectID}}
+		}
+
+		return []value{{"", noProject}}
+	case DimensionClient:
+		if te.Project != nil && te.Project.ClientID != "" {
+			return []value{{te.Project.ClientID, te.Project.ClientName}}
+		}
+
+		return []value{{"", noClient}}
+	case DimensionTask:
+		if te.Task != nil {
+			return []value{{te.Task.ID, te.Task.Name}}
+		}
+
+		return []value{{"", noTask}}
+	case DimensionTag:
+		if len(te.Tags) == 0 {
+			return []value{{"", noTag}}
+		}
+
+		vs := make([]value, len(te.Tags))
+		for i, t := range te.Tags {
+			vs[i] = value{t.ID, t.Name}
+		}
+
+		return vs
+	case DimensionDescription:
+		if te.Description == "" {
+			return []value{{"", noDescription}}
+		}
+
+		return []value{{te.Description, te.Description}}
+	case DimensionDay:
+		s := start.Format("2006-01-02")
+		return []value{{s, s}}
+	case DimensionWeek:
+		f, _ := timehlp.GetWeekRange(start)
+		s := f.Format("2006-01-02")
+		return []value{{s, s}}
+	case DimensionMonth:
+		s := start.Format("2006-01")
+		return []value{{s, s}}
+	case DimensionBillable:
+		if te.Billable {
+			return []value{{"yes", "yes"}}
+		}
+
+		return []value{{"no", "no"}}
+	}
+
+	return []value{{"", ""}}
+}
+
+func isDate(d string) bool {
+	return d == DimensionDay || d == DimensionWeek || d == DimensionMonth
+}
+
+// node is a group being built
+type node struct {
+	group    Group
+	children map[string]*node
+}
+
+func (n *node) child(d string, v value) *node {
+	c, ok := n.children[v.key]
+	if !ok {
+		c = &node{
+			group:    Group{Dimension: d, Key: v.key, Name: v.name},
+			children: map[string]*node{},
+		}
+		n.children[v.key] = c
+	}
+
+	return c
+}
+
+func (n *node) groups() []Group {
+	if len(n.children) == 0 {
+		return nil
+	}
+
+	gs := make([]Group, 0, len(n.children))
+	for _, c := range n.children {
+		g := c.group
+		g.Groups = c.groups()
+		gs = append(gs, g)
+	}
+
+	sort.Slice(gs, func(i, j int) bool {
+		a, b := gs[i], gs[j]
+		if isDate(a.Dimension) {
+			return a.Key < b.Key
+		}
+
+		// groups without a value go last
+		if (a.Key == "") != (b.Key == "") {
+			return b.Key == ""
+		}
+
+		an, bn := strings.ToLower(a.Name), strings.ToLower(b.Name)
+		if an != bn {
+			return an < bn
+		}
+
+		return a.Key < b.Key
+	})
+
+	return gs
+}
+
+// New groups the time entries by the dimensions of the options. Time entries
+// with more than one tag are summed on each one of the tags, so the groups of
+// tags may add to more than their parent
+func New(tes []dto.TimeEntry, o Options) Summary {
+	if o.Location == nil {
+		o.Location = time.Local
+	}
+
+	s := Summary{GroupBy: o.GroupBy}
+	root := &node{children: map[string]*node{}}
+
+	for _, te := range tes {
+		end := o.Now
+		if te.TimeInterval.End != nil {
+			end = *te.TimeInterval.End
+		}
+
+		d := end.Sub(te.TimeInterval.Start)
+		s.Totals.add(d, te.Billable, te.HourlyRate)
+
+		nodes := []*node{root}
+		for _, dim := range o.GroupBy {
+			next := make([]*node, 0, len(nodes))
+			for _, n := range nodes {
+				for _, v := range values(te, dim, o.Location) {
+					c := n.child(dim, v)
+					c.group.Totals.add(d, te.Billable, te.HourlyRate)
+					next = append(next, c)
+				}
+			}
+
+			nodes = next
+		}
+	}
+
+	s.Groups = root.groups()
+	if s.Groups == nil {
+		s.Groups = []Group{}
+	}
+
+	return s
+}
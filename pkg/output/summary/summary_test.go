@@ -0,0 +1,199 @@
+package summary_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/summary"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSummary(groupBy ...string) summary.Summary {
+	l := time.FixedZone("test", -3*60*60)
+	at := func(d, h int) time.Time {
+		return time.Date(2026, 10, d, h, 0, 0, 0, l)
+	}
+	end := func(d, h int) *time.Time {
+		t := at(d, h)
+		return &t
+	}
+
+	cli := &dto.Project{ID: "p1", Name: "CLI",
+		ClientID: "c1", ClientName: "Open Source"}
+	special := &dto.Project{ID: "p2", Name: "special"}
+	usd := dto.Rate{Amount: 2000, Currency: "USD"}
+
+	return summary.New([]dto.TimeEntry{
+		{
+			Project:     cli,
+			Task:        &dto.Task{ID: "t2", Name: "Reports"},
+			Billable:    true,
+			HourlyRate:  usd,
+			Description: "summary",
+			Tags: []dto.Tag{
+				{ID: "tg1", Name: "Development"},
+				{ID: "tg2", Name: "Review"},
+			},
+			TimeInterval: dto.TimeInterval{
+				Start: at(12, 9), End: end(12, 12)},
+		},
+		{
+			Project:    cli,
+			Task:       &dto.Task{ID: "t1", Name: "Dashboard"},
+			Billable:   true,
+			HourlyRate: usd,
+			Tags:       []dto.Tag{{ID: "tg1", Name: "Development"}},
+			TimeInterval: dto.TimeInterval{
+				Start: at(13, 9), End: end(13, 11)},
+		},
+		{
+			Description: "lunch",
+			TimeInterval: dto.TimeInterval{
+				Start: at(13, 12), End: end(13, 13)},
+		},
+		{
+			Project:     special,
+			Description: "meeting",
+			TimeInterval: dto.TimeInterval{
+				Start: at(18, 9)},
+		},
+	}, summary.Options{
+		GroupBy:  groupBy,
+		Location: l,
+		Now:      at(18, 10),
+	})
+}
+
+func TestCheckDimensions(t *testing.T) {
+	assert.NoError(t, summary.CheckDimensions([]string{"project", "task"}))
+	assert.EqualError(t, summary.CheckDimensions([]string{}),
+		"at least one dimension must be informed")
+	assert.EqualError(t, summary.CheckDimensions([]string{"task", "task"}),
+		`dimension "task" is repeated`)
+	assert.Regexp(t, `dimension "user" is not valid`,
+		summary.CheckDimensions([]string{"user"}).Error())
+}
+
+func TestNew(t *testing.T) {
+	s := newSummary("project", "task")
+
+	assert.Equal(t, 7*time.Hour, s.Duration)
+	assert.Equal(t, 5*time.Hour, s.BillableDuration)
+	assert.Equal(t, []summary.Amount{{Currency: "USD", Value: 10000}},
+		s.Amounts)
+
+	if !assert.Len(t, s.Groups, 3) {
+		return
+	}
+
+	assert.Equal(t, "CLI", s.Groups[0].Name)
+	assert.Equal(t, 5*time.Hour, s.Groups[0].Duration)
+	if assert.Len(t, s.Groups[0].Groups, 2) {
+		assert.Equal(t, "Dashboard", s.Groups[0].Groups[0].Name)
+		assert.Equal(t, "task", s.Groups[0].Groups[0].Dimension)
+		assert.Equal(t, 2*time.Hour, s.Groups[0].Groups[0].Duration)
+	}
+	assert.Equal(t, "special", s.Groups[1].Name)
+	assert.Equal(t, "(no project)", s.Groups[2].Name)
+
+	s = newSummary("tag")
+	if assert.Len(t, s.Groups, 3) {
+		assert.Equal(t, "Development", s.Groups[0].Name)
+		assert.Equal(t, 5*time.Hour, s.Groups[0].Duration)
+		assert.Equal(t, "Review", s.Groups[1].Name)
+		assert.Equal(t, 3*time.Hour, s.Groups[1].Duration)
+		assert.Equal(t, "(no tag)", s.Groups[2].Name)
+	}
+
+	s = newSummary("week", "billable")
+	if assert.Len(t, s.Groups, 2) {
+		assert.Equal(t, "2026-10-11", s.Groups[0].Name)
+		assert.Equal(t, "2026-10-18", s.Groups[1].Name)
+		if assert.Len(t, s.Groups[0].Groups, 2) {
+			assert.Equal(t, "no", s.Groups[0].Groups[0].Name)
+			assert.Equal(t, "yes", s.Groups[0].Groups[1].Name)
+		}
+	}
+}
+
+func TestNew_LargeAmounts(t *testing.T) {
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+
+	s := summary.New([]dto.TimeEntry{
+		{
+			Billable:     true,
+			HourlyRate:   dto.Rate{Amount: 1000000, Currency: "JPY"},
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+	}, summary.Options{Location: time.UTC, Now: end})
+
+	assert.Equal(t, []summary.Amount{{Currency: "JPY", Value: 3000000}},
+		s.Amounts)
+}
+
+func TestSummaryPrint(t *testing.T) {
+	b := &strings.Builder{}
+	err := summary.SummaryPrint(newSummary("client", "project"), b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		+-------------+--------------+----------+----------+------------+
+		|   Client    |   Project    | Duration | Billable |   Amount   |
+		+-------------+--------------+----------+----------+------------+
+		| Open Source |              |  5:00:00 |  5:00:00 | USD 100.00 |
+		|             | CLI          |  5:00:00 |  5:00:00 | USD 100.00 |
+		| (no client) |              |  2:00:00 |  0:00:00 |            |
+		|             | special      |  1:00:00 |  0:00:00 |            |
+		|             | (no project) |  1:00:00 |  0:00:00 |            |
+		+-------------+--------------+----------+----------+------------+
+		|       Total |              |  7:00:00 |  5:00:00 | USD 100.00 |
+		+-------------+--------------+----------+----------+------------+
+	`), b.String())
+}
+
+func TestSummaryCSVPrint(t *testing.T) {
+	b := &strings.Builder{}
+	err := summary.SummaryCSVPrint(newSummary("project", "task"), b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		project.key,project.name,task.key,task.name,duration,billableDuration,amount
+		p1,CLI,,,5:00:00,5:00:00,USD 100.00
+		p1,CLI,t1,Dashboard,2:00:00,2:00:00,USD 40.00
+		p1,CLI,t2,Reports,3:00:00,3:00:00,USD 60.00
+		p2,special,,,1:00:00,0:00:00,
+		p2,special,,(no task),1:00:00,0:00:00,
+		,(no project),,,1:00:00,0:00:00,
+		,(no project),,(no task),1:00:00,0:00:00,
+		,total,,,7:00:00,5:00:00,USD 100.00
+	`), b.String())
+}
+
+func TestSummaryJSONPrint(t *testing.T) {
+	b := &strings.Builder{}
+	err := summary.SummaryJSONPrint(newSummary("billable"), b)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"groupBy": ["billable"],
+		"groups": [
+			{
+				"dimension": "billable", "key": "no", "name": "no",
+				"duration": "PT2H0M0S", "billableDuration": "PT0S",
+				"amounts": []
+			},
+			{
+				"dimension": "billable", "key": "yes", "name": "yes",
+				"duration": "PT5H0M0S", "billableDuration": "PT5H0M0S",
+				"amounts": [{"currency": "USD", "amount": 100}]
+			}
+		],
+		"duration": "PT7H0M0S",
+		"billableDuration": "PT5H0M0S",
+		"amounts": [{"currency": "USD", "amount": 100}]
+	}`, b.String())
+}
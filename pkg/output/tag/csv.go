@@ -0,0 +1,36 @@
+package tag
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TagsCSVPrint will print as CSV
+func TagsCSVPrint(ts []dto.Tag, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"id",
+		"name",
+		"archived",
+	}); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(ts); i++ {
+		t := ts[i]
+		if err := w.Write([]string{
+			t.ID,
+			t.Name,
+			fmt.Sprintf("%v", t.Archived),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -10,13 +10,19 @@ import (
 // TagPrint will print more details
 func TagPrint(ts []dto.Tag, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name"})
+	tw.SetHeader([]string{"ID", "Name", "Archived"})
+
+	yesNo := map[bool]string{
+		true:  "YES",
+		false: "NO",
+	}
 
 	lines := make([][]string, len(ts))
 	for i := 0; i < len(ts); i++ {
 		lines[i] = []string{
 			ts[i].ID,
 			ts[i].Name,
+			yesNo[ts[i].Archived],
 		}
 	}
 
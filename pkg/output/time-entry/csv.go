@@ -11,9 +11,21 @@ import (
 
 // TimeEntriesCSVPrint will print each time entry using the format string
 func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
+	return timeEntriesCSVPrint(timeEntries, nil, out)
+}
+
+// TimeEntriesCSVPrintWithEarnings will print each time entry with the
+// currency, amount earned, cost and profit of them
+func TimeEntriesCSVPrintWithEarnings(
+	timeEntries []dto.TimeEntry, eo EarningsOptions, out io.Writer) error {
+	return timeEntriesCSVPrint(timeEntries, &eo, out)
+}
+
+func timeEntriesCSVPrint(
+	timeEntries []dto.TimeEntry, eo *EarningsOptions, out io.Writer) error {
 	w := csv.NewWriter(out)
 
-	if err := w.Write([]string{
+	header := []string{
 		"id",
 		"description",
 		"project.id",
@@ -26,9 +38,21 @@ func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
 		"user.id",
 		"user.email",
 		"user.name",
+	}
+
+	if eo != nil {
+		header = append(header,
+			"earnings.currency",
+			"earnings.earned",
+			"earnings.cost",
+			"earnings.profit",
+		)
+	}
+
+	if err := w.Write(append(header,
 		"tags...",
 		"customFields...",
-	}); err != nil {
+	)); err != nil {
 		return err
 	}
 
@@ -76,6 +100,22 @@ func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
 			te.User.Name,
 		}
 
+		if eo != nil {
+			e := eo.Earnings[te.ID]
+			cost, profit := "", ""
+			if !e.CostUnknown {
+				cost = amountToString(e.Cost)
+				profit = amountToString(e.Profit())
+			}
+
+			arr = append(arr,
+				e.Currency,
+				amountToString(e.Earned),
+				cost,
+				profit,
+			)
+		}
+
 		arr = append(arr, strings.Join(tagsToStringSlice(te.Tags), ";"))
 		arr = append(arr, strings.Join(customFieldsToStringSlice(te.CustomFields), ";"))
 
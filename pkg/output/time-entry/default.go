@@ -11,6 +11,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
 	"golang.org/x/term"
+	"golang.org/x/text/message"
 )
 
 func sumTimeEntriesDuration(ts []dto.TimeEntry) time.Duration {
@@ -40,6 +41,7 @@ type TimeEntryOutputOptions struct {
 	ShowClients       bool
 	ShowTotalDuration bool
 	TimeFormat        string
+	Earnings          *EarningsOptions
 }
 
 // NewTimeEntryOutputOptions creates a default TimeEntryOutputOptions
@@ -86,6 +88,14 @@ func (teo TimeEntryOutputOptions) WithTotalDuration() TimeEntryOutputOptions {
 	return teo
 }
 
+// WithEarnings shows columns with the amount earned, the cost and the profit
+// of each time entry, and their totals by currency
+func (teo TimeEntryOutputOptions) WithEarnings(
+	eo EarningsOptions) TimeEntryOutputOptions {
+	teo.Earnings = &eo
+	return teo
+}
+
 // TimeEntriesPrint will print more details
 func TimeEntriesPrint(
 	options TimeEntryOutputOptions) func([]dto.TimeEntry, io.Writer) error {
@@ -108,6 +118,12 @@ func TimeEntriesPrint(
 			header = append(header, "Custom Fields")
 		}
 
+		var p *message.Printer
+		if options.Earnings != nil {
+			p = message.NewPrinter(options.Earnings.Language)
+			header = append(header, "Earned", "Cost", "Profit")
+		}
+
 		tw.SetHeader(header)
 		tw.SetRowLine(true)
 		if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
@@ -171,14 +187,38 @@ func TimeEntriesPrint(
 				)
 			}
 
+			if options.Earnings != nil {
+				e := options.Earnings.view(
+					p, options.Earnings.Earnings[t.ID])
+				line = append(line, e.Earned, e.Cost, e.Profit)
+			}
+
 			tw.Rich(line, colors)
 		}
 
-		if options.ShowTotalDuration {
+		if options.ShowTotalDuration || options.Earnings != nil {
 			line := make([]string, len(header))
 			line[0] = "TOTAL"
 			line[3] = durationToString(sumTimeEntriesDuration(timeEntries))
-			tw.Append(line)
+
+			var ts []earningsView
+			if options.Earnings != nil {
+				ts = options.Earnings.totals(timeEntries)
+			}
+
+			if len(ts) == 0 {
+				tw.Append(line)
+			}
+
+			// one line for each currency
+			for _, e := range ts {
+				line[len(line)-3] = e.Earned
+				line[len(line)-2] = e.Cost
+				line[len(line)-1] = e.Profit
+				tw.Append(line)
+
+				line = make([]string, len(header))
+			}
 		}
 
 		tw.Render()
@@ -0,0 +1,59 @@
+package timeentry
+
+import (
+	"fmt"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// EarningsOptions sets the earnings to be shown with the time entries
+type EarningsOptions struct {
+	Earnings ratehlp.Earnings
+	// Language is used to format the amounts on the table and markdown
+	// outputs
+	Language language.Tag
+}
+
+// amountToString formats cents as a plain decimal, for machine readable
+// outputs
+func amountToString(cents int64) string {
+	return fmt.Sprintf("%.2f", float64(cents)/100)
+}
+
+type earningsView struct {
+	Currency string
+	Earned   string
+	Cost     string
+	Profit   string
+}
+
+func (eo EarningsOptions) view(
+	p *message.Printer, e ratehlp.Earning) earningsView {
+	v := earningsView{
+		Currency: e.Currency,
+		Earned:   ratehlp.FormatAmount(p, e.Currency, e.Earned),
+	}
+
+	if !e.CostUnknown {
+		v.Cost = ratehlp.FormatAmount(p, e.Currency, e.Cost)
+		v.Profit = ratehlp.FormatAmount(p, e.Currency, e.Profit())
+	}
+
+	return v
+}
+
+// totals formats the sum of the earnings of the time entries, one for each
+// currency
+func (eo EarningsOptions) totals(tes []dto.TimeEntry) []earningsView {
+	p := message.NewPrinter(eo.Language)
+	ts := eo.Earnings.Totals(tes)
+	vs := make([]earningsView, len(ts))
+	for i := range ts {
+		vs[i] = eo.view(p, ts[i])
+	}
+
+	return vs
+}
@@ -0,0 +1,128 @@
+package timeentry_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func earningsFixture() ([]dto.TimeEntry, timeentry.EarningsOptions) {
+	start, _ := time.Parse(timehlp.FullTimeFormat, "2024-06-15 10:00:00")
+	end := start.Add(2 * time.Hour)
+
+	return []dto.TimeEntry{
+		{
+			ID:           "te1",
+			Billable:     true,
+			Description:  "In dollars",
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		},
+		{
+			ID:           "te2",
+			Billable:     true,
+			Description:  "In reais",
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		},
+		{
+			ID:           "te3",
+			Description:  "Also in dollars",
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		},
+	}, timeentry.EarningsOptions{
+		Language: language.BrazilianPortuguese,
+		Earnings: ratehlp.Earnings{
+			"te1": {Currency: "USD", Earned: 400000, Cost: 100050},
+			"te2": {Currency: "BRL", Earned: 20000, Cost: 0},
+			"te3": {Currency: "USD", Earned: 0, Cost: 1000},
+		},
+	}
+}
+
+func TestTimeEntriesCSVPrintWithEarnings(t *testing.T) {
+	tes, eo := earningsFixture()
+	b := &strings.Builder{}
+
+	err := timeentry.TimeEntriesCSVPrintWithEarnings(tes, eo, b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	assert.Equal(t, "id,description,project.id,project.name,task.id,"+
+		"task.name,start,end,duration,user.id,user.email,user.name,"+
+		"earnings.currency,earnings.earned,earnings.cost,earnings.profit,"+
+		"tags...,customFields...", lines[0])
+	assert.Regexp(t, `^te1,In dollars,.*,USD,4000.00,1000.50,2999.50,,$`,
+		lines[1])
+	assert.Regexp(t, `^te3,Also in dollars,.*,USD,0.00,10.00,-10.00,,$`,
+		lines[3])
+}
+
+func TestTimeEntriesJSONPrintWithEarnings(t *testing.T) {
+	tes, eo := earningsFixture()
+	b := &strings.Builder{}
+
+	err := timeentry.TimeEntriesJSONPrintWithEarnings(tes[:1], eo, b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, b.String(), `"id":"te1"`)
+	assert.Contains(t, b.String(), `"earnings":{"currency":"USD",`+
+		`"earned":4000,"cost":1000.5,"profit":2999.5}`)
+}
+
+func TestTimeEntriesMarkdownPrintWithEarnings(t *testing.T) {
+	tes, eo := earningsFixture()
+	b := &strings.Builder{}
+
+	err := timeentry.TimeEntriesMarkdownPrintWithEarnings(tes, eo, b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, b.String(), heredoc.Doc(`
+		| _Billable_      | Yes          |
+		| _Earned_        | USD 4.000,00 |
+		| _Cost_          | USD 1.000,50 |
+		| _Profit_        | USD 2.999,50 |
+	`))
+
+	assert.True(t, strings.HasSuffix(b.String(), heredoc.Doc(`
+		## _Totals_
+
+		| Currency | Earned | Cost | Profit |
+		|----------|-------:|-----:|-------:|
+		| BRL | BRL 200,00 | BRL 0,00 | BRL 200,00 |
+		| USD | USD 4.000,00 | USD 1.010,50 | USD 2.989,50 |
+	`)), b.String())
+}
+
+func TestTimeEntriesPrintWithEarnings(t *testing.T) {
+	tes, eo := earningsFixture()
+	b := &strings.Builder{}
+
+	err := timeentry.TimeEntriesPrint(timeentry.NewTimeEntryOutputOptions().
+		WithEarnings(eo))(tes[:2], b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, b.String(),
+		"| USD 4.000,00 | USD 1.000,50 | USD 2.999,50 |")
+	assert.Contains(t, b.String(),
+		"| TOTAL |          |          | 4:00:00 |         |             |"+
+			"      | BRL 200,00   | BRL 0,00     | BRL 200,00   |\n"+
+			"+-------+----------+----------+---------+---------+-------------+"+
+			"------+--------------+--------------+--------------+\n"+
+			"|       |          |          |         |         |             |"+
+			"      | USD 4.000,00 | USD 1.000,50 | USD 2.999,50 |")
+}
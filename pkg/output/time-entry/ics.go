@@ -0,0 +1,106 @@
+package timeentry
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+const (
+	icsTimeFormat = "20060102T150405Z"
+	icsLineLimit  = 75
+)
+
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// TimeEntriesICSPrint will print the time entries as a iCalendar (RFC 5545),
+// each one as a VEVENT. Time entries without ID (like the ones created to
+// fill missing dates) are ignored, and running ones end at the current time
+func TimeEntriesICSPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
+	w := bufio.NewWriter(out)
+	now := timehlp.Now().UTC()
+
+	line := func(name, value string) {
+		l := name + ":" + value
+		for len(l) > icsLineLimit {
+			i := icsLineLimit
+			for !utf8.RuneStart(l[i]) {
+				i--
+			}
+
+			_, _ = w.WriteString(l[:i] + "\r\n")
+			l = " " + l[i:]
+		}
+
+		_, _ = w.WriteString(l + "\r\n")
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//lucassabreu//clockify-cli//EN")
+	line("CALSCALE", "GREGORIAN")
+
+	for _, te := range timeEntries {
+		if te.ID == "" {
+			continue
+		}
+
+		end := now
+		if te.TimeInterval.End != nil {
+			end = *te.TimeInterval.End
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", te.ID+"@clockify.me")
+		line("DTSTAMP", now.Format(icsTimeFormat))
+		line("DTSTART", te.TimeInterval.Start.UTC().Format(icsTimeFormat))
+		line("DTEND", end.UTC().Format(icsTimeFormat))
+		line("SUMMARY", icsEscaper.Replace(icsSummary(te)))
+
+		if len(te.Tags) > 0 {
+			tags := make([]string, len(te.Tags))
+			for i := range te.Tags {
+				tags[i] = icsEscaper.Replace(te.Tags[i].Name)
+			}
+			line("CATEGORIES", strings.Join(tags, ","))
+		}
+
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return w.Flush()
+}
+
+// icsSummary joins the description, project and task of the time entry
+func icsSummary(te dto.TimeEntry) string {
+	s := make([]string, 0, 3)
+	if te.Description != "" {
+		s = append(s, te.Description)
+	}
+
+	if te.Project != nil && te.Project.Name != "" {
+		s = append(s, te.Project.Name)
+	}
+
+	if te.Task != nil && te.Task.Name != "" {
+		s = append(s, te.Task.Name)
+	}
+
+	if len(s) == 0 {
+		return "No Description"
+	}
+
+	return strings.Join(s, " - ")
+}
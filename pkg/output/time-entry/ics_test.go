@@ -0,0 +1,64 @@
+package timeentry_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntriesICSPrint(t *testing.T) {
+	start := time.Date(2026, 10, 14, 9, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+	end := start.Add(90 * time.Minute)
+
+	b := &strings.Builder{}
+	err := timeentry.TimeEntriesICSPrint([]dto.TimeEntry{
+		{
+			ID:           "te1",
+			Description:  "Planning; sprint, 42",
+			Project:      &dto.Project{Name: "Clockify CLI"},
+			Task:         &dto.Task{Name: "Report"},
+			Tags:         []dto.Tag{{Name: "Meeting"}, {Name: "Dev, Ops"}},
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		},
+		{
+			TimeInterval: dto.NewTimeInterval(start, &start),
+		},
+		{
+			ID:           "te2",
+			Description:  strings.Repeat("á", 40),
+			TimeInterval: dto.NewTimeInterval(start, nil),
+		},
+		{
+			ID:           "te3",
+			TimeInterval: dto.NewTimeInterval(start, &end),
+		},
+	}, b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	out := b.String()
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	for _, l := range lines {
+		assert.LessOrEqual(t, len(l), 75, l)
+	}
+
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	assert.Equal(t, 3, strings.Count(out, "BEGIN:VEVENT\r\n"))
+
+	assert.Contains(t, out, "BEGIN:VEVENT\r\nUID:te1@clockify.me\r\n")
+	assert.Contains(t, out, "DTSTART:20261014T120000Z\r\n"+
+		"DTEND:20261014T133000Z\r\n"+
+		`SUMMARY:Planning\; sprint\, 42 - Clockify CLI - Report`+"\r\n"+
+		`CATEGORIES:Meeting,Dev\, Ops`+"\r\n"+
+		"END:VEVENT\r\n")
+
+	assert.Contains(t, out, "SUMMARY:"+strings.Repeat("á", 33)+"\r\n "+
+		strings.Repeat("á", 7)+"\r\n")
+	assert.Contains(t, out, "SUMMARY:No Description\r\n")
+}
@@ -16,3 +16,42 @@ func TimeEntryJSONPrint(t dto.TimeEntry, w io.Writer) error {
 func TimeEntriesJSONPrint(t []dto.TimeEntry, w io.Writer) error {
 	return json.NewEncoder(w).Encode(t)
 }
+
+type jsonEarnings struct {
+	Currency string  `json:"currency"`
+	Earned   float64 `json:"earned"`
+	// Cost and Profit are null when the cost is in another currency
+	Cost   *float64 `json:"cost"`
+	Profit *float64 `json:"profit"`
+}
+
+type jsonTimeEntryWithEarnings struct {
+	dto.TimeEntry
+	Earnings jsonEarnings `json:"earnings"`
+}
+
+// TimeEntriesJSONPrintWithEarnings will print as JSON, adding the currency,
+// amount earned, cost and profit of each time entry
+func TimeEntriesJSONPrintWithEarnings(
+	t []dto.TimeEntry, eo EarningsOptions, w io.Writer) error {
+	j := make([]jsonTimeEntryWithEarnings, len(t))
+	for i := range t {
+		e := eo.Earnings[t[i].ID]
+		j[i] = jsonTimeEntryWithEarnings{
+			TimeEntry: t[i],
+			Earnings: jsonEarnings{
+				Currency: e.Currency,
+				Earned:   float64(e.Earned) / 100,
+			},
+		}
+
+		if !e.CostUnknown {
+			cost := float64(e.Cost) / 100
+			profit := float64(e.Profit()) / 100
+			j[i].Earnings.Cost = &cost
+			j[i].Earnings.Profit = &profit
+		}
+	}
+
+	return json.NewEncoder(w).Encode(j)
+}
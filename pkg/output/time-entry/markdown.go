@@ -2,6 +2,7 @@ package timeentry
 
 import (
 	_ "embed"
+	"fmt"
 	"io"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
@@ -14,3 +15,28 @@ var mdTemplate string
 func TimeEntriesMarkdownPrint(tes []dto.TimeEntry, w io.Writer) error {
 	return TimeEntriesPrintWithTemplate(mdTemplate)(tes, w)
 }
+
+// TimeEntriesMarkdownPrintWithEarnings will print time entries in "markdown
+// blocks" with the amount earned, cost and profit of them, followed by their
+// totals by currency
+func TimeEntriesMarkdownPrintWithEarnings(
+	tes []dto.TimeEntry, eo EarningsOptions, w io.Writer) error {
+	if err := timeEntriesPrintWithTemplate(mdTemplate, &eo)(tes, w); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(w, "\n## _Totals_\n\n"+
+		"| Currency | Earned | Cost | Profit |\n"+
+		"|----------|-------:|-----:|-------:|\n"); err != nil {
+		return err
+	}
+
+	for _, t := range eo.totals(tes) {
+		if _, err := fmt.Fprintf(w, "| %s | %s | %s | %s |\n",
+			t.Currency, t.Earned, t.Cost, t.Profit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,12 +5,22 @@ import (
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"golang.org/x/text/message"
 )
 
 // TimeEntriesPrintWithTemplate will print each time entry using the format
 // string
 func TimeEntriesPrintWithTemplate(
 	format string,
+) func([]dto.TimeEntry, io.Writer) error {
+	return timeEntriesPrintWithTemplate(format, nil)
+}
+
+// timeEntriesPrintWithTemplate prints each time entry using the format, when
+// eo is set the formatted earnings of the time entry are available as
+// .Earnings
+func timeEntriesPrintWithTemplate(
+	format string, eo *EarningsOptions,
 ) func([]dto.TimeEntry, io.Writer) error {
 	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
 		t, err := util.NewTemplate(format)
@@ -18,16 +28,29 @@ func TimeEntriesPrintWithTemplate(
 			return err
 		}
 
+		var p *message.Printer
+		if eo != nil {
+			p = message.NewPrinter(eo.Language)
+		}
+
 		l := len(timeEntries)
 		for i := 0; i < l; i++ {
+			var e *earningsView
+			if eo != nil {
+				v := eo.view(p, eo.Earnings[timeEntries[i].ID])
+				e = &v
+			}
+
 			if err := t.Execute(w, struct {
 				dto.TimeEntry
-				First bool
-				Last  bool
+				First    bool
+				Last     bool
+				Earnings *earningsView
 			}{
 				TimeEntry: timeEntries[i],
 				First:     i == 0,
 				Last:      i == (l - 1),
+				Earnings:  e,
 			}); err != nil {
 				return err
 			}
@@ -0,0 +1,57 @@
+package timesheet
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// TimesheetCSVPrint prints the timesheet as CSV, with a line of totals at
+// the end
+func TimesheetCSVPrint(ts Timesheet, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	header := []string{"project.id", "project.name", "client.name"}
+	if ts.ByTask {
+		header = append(header, "task.id", "task.name")
+	}
+
+	for _, d := range ts.Days {
+		header = append(header, d.Date.Format("2006-01-02"))
+	}
+
+	if err := w.Write(append(header, "total")); err != nil {
+		return err
+	}
+
+	for _, r := range ts.Rows {
+		line := []string{r.ProjectID, r.ProjectName, r.ClientName}
+		if ts.ByTask {
+			line = append(line, r.TaskID, r.TaskName)
+		}
+
+		for _, d := range r.Durations {
+			line = append(line, durationToString(d))
+		}
+
+		if err := w.Write(
+			append(line, durationToString(r.Total))); err != nil {
+			return err
+		}
+	}
+
+	line := []string{"", "total", ""}
+	if ts.ByTask {
+		line = append(line, "", "")
+	}
+
+	for _, d := range ts.Days {
+		line = append(line, durationToString(d.Total))
+	}
+
+	if err := w.Write(append(line, durationToString(ts.Total))); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,127 @@
+package timesheet
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/olekukonko/tablewriter"
+)
+
+const (
+	dayFormat = "Mon 01-02"
+	noProject = "(no project)"
+	noTask    = "(no task)"
+)
+
+func durationToString(d time.Duration) string {
+	return dto.Duration{Duration: d}.HumanString()
+}
+
+// cell shows empty cells for days without time
+func cell(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	return durationToString(d)
+}
+
+func (r Row) project() string {
+	if r.ProjectName != "" {
+		return r.ProjectName
+	}
+
+	if r.ProjectID != "" {
+		return r.ProjectID
+	}
+
+	return noProject
+}
+
+func (r Row) task() string {
+	if r.TaskName != "" {
+		return r.TaskName
+	}
+
+	return noTask
+}
+
+// dayHeader names the day, marking the ones that are not workdays
+func dayHeader(d Day, mark string) string {
+	if d.Workday {
+		return d.Date.Format(dayFormat)
+	}
+
+	return d.Date.Format(dayFormat) + mark
+}
+
+func hasNonWorkdays(ts Timesheet) bool {
+	for _, d := range ts.Days {
+		if !d.Workday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimesheetPrint prints the timesheet as a table, days that are not workdays
+// are marked with "*"
+func TimesheetPrint(ts Timesheet, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetAutoFormatHeaders(false)
+
+	header := []string{"Project"}
+	if ts.ByTask {
+		header = append(header, "Task")
+	}
+
+	for _, d := range ts.Days {
+		header = append(header, dayHeader(d, " *"))
+	}
+	tw.SetHeader(append(header, "Total"))
+
+	align := make([]int, len(header)+1)
+	for i := range align {
+		align[i] = tablewriter.ALIGN_RIGHT
+		if i < len(header)-len(ts.Days) {
+			align[i] = tablewriter.ALIGN_LEFT
+		}
+	}
+	tw.SetColumnAlignment(align)
+
+	for _, r := range ts.Rows {
+		line := []string{r.project()}
+		if ts.ByTask {
+			line = append(line, r.task())
+		}
+
+		for _, d := range r.Durations {
+			line = append(line, cell(d))
+		}
+
+		tw.Append(append(line, durationToString(r.Total)))
+	}
+
+	footer := []string{"Total"}
+	if ts.ByTask {
+		footer = append(footer, "")
+	}
+
+	for _, d := range ts.Days {
+		footer = append(footer, durationToString(d.Total))
+	}
+	tw.SetFooter(append(footer, durationToString(ts.Total)))
+	tw.SetFooterAlignment(tablewriter.ALIGN_RIGHT)
+
+	tw.Render()
+
+	if hasNonWorkdays(ts) {
+		_, err := fmt.Fprintln(w, "* not a workday")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package timesheet
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type jsonDay struct {
+	Date    string       `json:"date"`
+	Workday bool         `json:"workday"`
+	Total   dto.Duration `json:"total"`
+}
+
+type jsonRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type jsonProject struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ClientName string `json:"clientName"`
+}
+
+type jsonRow struct {
+	Project   jsonProject    `json:"project"`
+	Task      *jsonRef       `json:"task,omitempty"`
+	Durations []dto.Duration `json:"durations"`
+	Total     dto.Duration   `json:"total"`
+}
+
+type jsonTimesheet struct {
+	Days  []jsonDay    `json:"days"`
+	Rows  []jsonRow    `json:"rows"`
+	Total dto.Duration `json:"total"`
+}
+
+func toDuration(d time.Duration) dto.Duration {
+	return dto.Duration{Duration: d}
+}
+
+// TimesheetJSONPrint prints the timesheet as JSON
+func TimesheetJSONPrint(ts Timesheet, w io.Writer) error {
+	j := jsonTimesheet{
+		Days:  make([]jsonDay, len(ts.Days)),
+		Rows:  make([]jsonRow, len(ts.Rows)),
+		Total: toDuration(ts.Total),
+	}
+
+	for i, d := range ts.Days {
+		j.Days[i] = jsonDay{
+			Date:    d.Date.Format("2006-01-02"),
+			Workday: d.Workday,
+			Total:   toDuration(d.Total),
+		}
+	}
+
+	for i, r := range ts.Rows {
+		jr := jsonRow{
+			Project: jsonProject{
+				ID:         r.ProjectID,
+				Name:       r.ProjectName,
+				ClientName: r.ClientName,
+			},
+			Durations: make([]dto.Duration, len(r.Durations)),
+			Total:     toDuration(r.Total),
+		}
+
+		if ts.ByTask {
+			jr.Task = &jsonRef{ID: r.TaskID, Name: r.TaskName}
+		}
+
+		for k, d := range r.Durations {
+			jr.Durations[k] = toDuration(d)
+		}
+
+		j.Rows[i] = jr
+	}
+
+	return json.NewEncoder(w).Encode(j)
+}
@@ -0,0 +1,68 @@
+package timesheet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TimesheetMarkdownPrint prints the timesheet as a markdown table, days that
+// are not workdays are shown in italic
+func TimesheetMarkdownPrint(ts Timesheet, w io.Writer) error {
+	header := []string{"Project"}
+	if ts.ByTask {
+		header = append(header, "Task")
+	}
+
+	align := make([]string, len(header))
+	for i := range align {
+		align[i] = "---"
+	}
+
+	for _, d := range ts.Days {
+		h := dayHeader(d, "")
+		if !d.Workday {
+			h = "_" + h + "_"
+		}
+
+		header = append(header, h)
+		align = append(align, "---:")
+	}
+
+	header = append(header, "Total")
+	align = append(align, "---:")
+
+	lines := [][]string{header, align}
+	for _, r := range ts.Rows {
+		line := []string{r.project()}
+		if ts.ByTask {
+			line = append(line, r.task())
+		}
+
+		for _, d := range r.Durations {
+			line = append(line, cell(d))
+		}
+
+		lines = append(lines, append(line, durationToString(r.Total)))
+	}
+
+	footer := []string{"**Total**"}
+	if ts.ByTask {
+		footer = append(footer, "")
+	}
+
+	for _, d := range ts.Days {
+		footer = append(footer, "**"+durationToString(d.Total)+"**")
+	}
+
+	lines = append(lines,
+		append(footer, "**"+durationToString(ts.Total)+"**"))
+
+	for _, l := range lines {
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(l, " | ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
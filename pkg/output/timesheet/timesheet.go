@@ -0,0 +1,155 @@
+package timesheet
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Day is a column of the timesheet
+type Day struct {
+	Date    time.Time
+	Workday bool
+	Total   time.Duration
+}
+
+// Row is the time spent on a project, or project and task, on each day of
+// the timesheet
+type Row struct {
+	ProjectID   string
+	ProjectName string
+	ClientName  string
+	TaskID      string
+	TaskName    string
+
+	Durations []time.Duration
+	Total     time.Duration
+}
+
+// Timesheet is a grid of the time spent on projects by day
+type Timesheet struct {
+	ByTask bool
+	Days   []Day
+	Rows   []Row
+	Total  time.Duration
+}
+
+// Options sets how the timesheet is built
+type Options struct {
+	// First and Last days of the timesheet, inclusive
+	First time.Time
+	Last  time.Time
+	// ByTask creates a row for each task of the projects
+	ByTask bool
+	// Workdays are the names of the week days expected to be worked, in
+	// lower case
+	Workdays []string
+	// Location is the time zone used to decide on which day a time entry was
+	// made
+	Location *time.Location
+	// Now is used as the end of running time entries
+	Now time.Time
+}
+
+func dayOf(t time.Time, l *time.Location) time.Time {
+	t = t.In(l)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, l)
+}
+
+// New builds a timesheet of the time entries, time entries out of the range
+// of days are ignored
+func New(tes []dto.TimeEntry, o Options) Timesheet {
+	if o.Location == nil {
+		o.Location = time.Local
+	}
+
+	workdays := make(map[string]bool, len(o.Workdays))
+	for _, w := range o.Workdays {
+		workdays[strings.ToLower(w)] = true
+	}
+
+	ts := Timesheet{ByTask: o.ByTask, Days: make([]Day, 0)}
+	index := map[time.Time]int{}
+	// dates from the arguments are in UTC, but represent days on the time
+	// zone
+	first := time.Date(o.First.Year(), o.First.Month(), o.First.Day(),
+		0, 0, 0, 0, o.Location)
+	last := time.Date(o.Last.Year(), o.Last.Month(), o.Last.Day(),
+		0, 0, 0, 0, o.Location)
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		index[d] = len(ts.Days)
+		ts.Days = append(ts.Days, Day{
+			Date:    d,
+			Workday: workdays[strings.ToLower(d.Weekday().String())],
+		})
+	}
+
+	rows := map[string]*Row{}
+	for _, te := range tes {
+		i, ok := index[dayOf(te.TimeInterval.Start, o.Location)]
+		if !ok {
+			continue
+		}
+
+		r := Row{}
+		if te.Project != nil {
+			r.ProjectID = te.Project.ID
+			r.ProjectName = te.Project.Name
+			r.ClientName = te.Project.ClientName
+		} else {
+			r.ProjectID = te.ProjectID
+		}
+
+		if o.ByTask && te.Task != nil {
+			r.TaskID = te.Task.ID
+			r.TaskName = te.Task.Name
+		}
+
+		key := r.ProjectID + "/" + r.TaskID
+		row, ok := rows[key]
+		if !ok {
+			r.Durations = make([]time.Duration, len(ts.Days))
+			row = &r
+			rows[key] = row
+		}
+
+		end := o.Now
+		if te.TimeInterval.End != nil {
+			end = *te.TimeInterval.End
+		}
+
+		d := end.Sub(te.TimeInterval.Start)
+		row.Durations[i] += d
+		row.Total += d
+		ts.Days[i].Total += d
+		ts.Total += d
+	}
+
+	ts.Rows = make([]Row, 0, len(rows))
+	for _, r := range rows {
+		ts.Rows = append(ts.Rows, *r)
+	}
+
+	sort.Slice(ts.Rows, func(i, j int) bool {
+		a, b := ts.Rows[i], ts.Rows[j]
+		if a.ProjectName != b.ProjectName {
+			// time entries without project go last
+			if a.ProjectName == "" || b.ProjectName == "" {
+				return b.ProjectName == ""
+			}
+
+			return strings.ToLower(a.ProjectName) <
+				strings.ToLower(b.ProjectName)
+		}
+
+		if a.ProjectID != b.ProjectID {
+			return a.ProjectID < b.ProjectID
+		}
+
+		return strings.ToLower(a.TaskName) < strings.ToLower(b.TaskName)
+	})
+
+	return ts
+}
@@ -0,0 +1,137 @@
+package timesheet_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/timesheet"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTimesheet(byTask bool) timesheet.Timesheet {
+	l := time.FixedZone("test", -3*60*60)
+	at := func(d, h int) time.Time {
+		return time.Date(2026, 10, d, h, 0, 0, 0, l)
+	}
+	end := func(d, h int) *time.Time {
+		t := at(d, h)
+		return &t
+	}
+
+	cli := &dto.Project{ID: "p1", Name: "CLI", ClientName: "Open Source"}
+	api := &dto.Project{ID: "p2", Name: "api"}
+
+	return timesheet.New([]dto.TimeEntry{
+		{
+			Project: cli,
+			Task:    &dto.Task{ID: "t2", Name: "Reports"},
+			TimeInterval: dto.TimeInterval{
+				Start: at(12, 9), End: end(12, 12)},
+		},
+		{
+			Project: cli,
+			Task:    &dto.Task{ID: "t1", Name: "Dashboard"},
+			TimeInterval: dto.TimeInterval{
+				Start: at(13, 9), End: end(13, 11)},
+		},
+		{
+			TimeInterval: dto.TimeInterval{
+				Start: at(13, 13), End: end(13, 14)},
+		},
+		{
+			Project: api,
+			TimeInterval: dto.TimeInterval{
+				Start: at(14, 9)},
+		},
+		{
+			// out of the range
+			Project: api,
+			TimeInterval: dto.TimeInterval{
+				Start: at(16, 9), End: end(16, 10)},
+		},
+	}, timesheet.Options{
+		First:    time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC),
+		Last:     time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		ByTask:   byTask,
+		Workdays: []string{"monday", "tuesday", "wednesday"},
+		Location: l,
+		Now:      at(14, 10),
+	})
+}
+
+func TestNew(t *testing.T) {
+	ts := newTimesheet(false)
+
+	if assert.Len(t, ts.Days, 4) {
+		assert.False(t, ts.Days[0].Workday)
+		assert.True(t, ts.Days[1].Workday)
+		assert.Equal(t, 3*time.Hour, ts.Days[2].Total)
+	}
+
+	if assert.Len(t, ts.Rows, 3) {
+		assert.Equal(t, "api", ts.Rows[0].ProjectName)
+		assert.Equal(t, "CLI", ts.Rows[1].ProjectName)
+		assert.Equal(t, 5*time.Hour, ts.Rows[1].Total)
+		assert.Equal(t, "", ts.Rows[2].ProjectID)
+	}
+
+	assert.Equal(t, 7*time.Hour, ts.Total)
+
+	ts = newTimesheet(true)
+	if assert.Len(t, ts.Rows, 4) {
+		assert.Equal(t, "Dashboard", ts.Rows[1].TaskName)
+		assert.Equal(t, "Reports", ts.Rows[2].TaskName)
+	}
+}
+
+func TestTimesheetCSVPrint(t *testing.T) {
+	b := &strings.Builder{}
+	err := timesheet.TimesheetCSVPrint(newTimesheet(true), b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		project.id,project.name,client.name,task.id,task.name,2026-10-11,2026-10-12,2026-10-13,2026-10-14,total
+		p2,api,,,,0:00:00,0:00:00,0:00:00,1:00:00,1:00:00
+		p1,CLI,Open Source,t1,Dashboard,0:00:00,0:00:00,2:00:00,0:00:00,2:00:00
+		p1,CLI,Open Source,t2,Reports,0:00:00,3:00:00,0:00:00,0:00:00,3:00:00
+		,,,,,0:00:00,0:00:00,1:00:00,0:00:00,1:00:00
+		,total,,,,0:00:00,3:00:00,3:00:00,1:00:00,7:00:00
+	`), b.String())
+}
+
+func TestTimesheetJSONPrint(t *testing.T) {
+	b := &strings.Builder{}
+	err := timesheet.TimesheetJSONPrint(newTimesheet(false), b)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"days": [
+			{"date": "2026-10-11", "workday": false, "total": "PT0S"},
+			{"date": "2026-10-12", "workday": true, "total": "PT3H0M0S"},
+			{"date": "2026-10-13", "workday": true, "total": "PT3H0M0S"},
+			{"date": "2026-10-14", "workday": true, "total": "PT1H0M0S"}
+		],
+		"rows": [
+			{
+				"project": {"id": "p2", "name": "api", "clientName": ""},
+				"durations": ["PT0S", "PT0S", "PT0S", "PT1H0M0S"],
+				"total": "PT1H0M0S"
+			},
+			{
+				"project": {"id": "p1", "name": "CLI",
+					"clientName": "Open Source"},
+				"durations": ["PT0S", "PT3H0M0S", "PT2H0M0S", "PT0S"],
+				"total": "PT5H0M0S"
+			},
+			{
+				"project": {"id": "", "name": "", "clientName": ""},
+				"durations": ["PT0S", "PT0S", "PT1H0M0S", "PT0S"],
+				"total": "PT1H0M0S"
+			}
+		],
+		"total": "PT7H0M0S"
+	}`, b.String())
+}
@@ -0,0 +1,21 @@
+package ratehlp
+
+import (
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// FormatAmount formats cents of a currency for the language of the printer,
+// like "USD 1,234.50"
+func FormatAmount(p *message.Printer, cur string, cents int64) string {
+	v := float64(cents) / 100
+	if u, err := currency.ParseISO(cur); err == nil {
+		return p.Sprint(currency.ISO(u.Amount(v)))
+	}
+
+	return strings.TrimSpace(
+		cur + " " + p.Sprint(number.Decimal(v, number.Scale(2))))
+}
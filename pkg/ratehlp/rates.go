@@ -0,0 +1,254 @@
+package ratehlp
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Rates finds the hourly and cost rates of the time entries of a user
+// following the hierarchy of Clockify, from the least to the most specific:
+// workspace, workspace membership, project, project membership and task
+type Rates struct {
+	userID    string
+	workspace dto.Workspace
+	projects  map[string]dto.Project
+}
+
+// New creates a Rates for the user, the projects should be hydrated to have
+// the rates of their tasks
+func New(userID string, w dto.Workspace, ps []dto.Project) Rates {
+	r := Rates{
+		userID:    userID,
+		workspace: w,
+		projects:  make(map[string]dto.Project, len(ps)),
+	}
+
+	for _, p := range ps {
+		r.projects[p.ID] = p
+	}
+
+	return r
+}
+
+// Load fetches the workspace and its projects to find the rates of the user
+func Load(c api.Client, workspace, userID string) (Rates, error) {
+	w, err := c.GetWorkspace(api.GetWorkspace{ID: workspace})
+	if err != nil {
+		return Rates{}, err
+	}
+
+	ps, err := c.GetProjects(api.GetProjectsParam{
+		Workspace:       workspace,
+		Hydrate:         true,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return Rates{}, err
+	}
+
+	return New(userID, w, ps), nil
+}
+
+func isSet(r *dto.Rate) bool {
+	return r != nil && r.Amount > 0
+}
+
+func membership(ms []dto.Membership, userID string) *dto.Membership {
+	for i := range ms {
+		if ms[i].UserID == userID {
+			return &ms[i]
+		}
+	}
+
+	return nil
+}
+
+func (r Rates) task(te dto.TimeEntry, p dto.Project) *dto.Task {
+	if te.Task == nil {
+		return nil
+	}
+
+	for i := range p.Tasks {
+		if p.Tasks[i].ID == te.Task.ID {
+			return &p.Tasks[i]
+		}
+	}
+
+	return te.Task
+}
+
+func (r Rates) project(te dto.TimeEntry) (dto.Project, bool) {
+	id := te.ProjectID
+	if te.Project != nil {
+		id = te.Project.ID
+	}
+
+	if p, ok := r.projects[id]; ok {
+		return p, true
+	}
+
+	if te.Project != nil {
+		return *te.Project, true
+	}
+
+	return dto.Project{}, false
+}
+
+// resolve walks the hierarchy using get to read the rate of each level
+func (r Rates) resolve(
+	te dto.TimeEntry,
+	workspace, project *dto.Rate,
+	get func(hourly, cost *dto.Rate) *dto.Rate,
+) dto.Rate {
+	rate := dto.Rate{}
+	use := func(c *dto.Rate) {
+		if isSet(c) {
+			rate = *c
+		}
+	}
+
+	use(workspace)
+	if m := membership(r.workspace.Memberships, r.userID); m != nil {
+		use(get(m.HourlyRate, m.CostRate))
+	}
+
+	p, ok := r.project(te)
+	if !ok {
+		return rate
+	}
+
+	use(project)
+	if m := membership(p.Memberships, r.userID); m != nil {
+		use(get(m.HourlyRate, m.CostRate))
+	}
+
+	if t := r.task(te, p); t != nil {
+		use(get(t.HourlyRate, t.CostRate))
+	}
+
+	return rate
+}
+
+// HourlyRate returns the billable rate of the time entry
+func (r Rates) HourlyRate(te dto.TimeEntry) dto.Rate {
+	var project *dto.Rate
+	if p, ok := r.project(te); ok {
+		project = &p.HourlyRate
+	}
+
+	w := r.workspace.HourlyRate
+	return r.resolve(te, &w, project,
+		func(hourly, _ *dto.Rate) *dto.Rate { return hourly })
+}
+
+// CostRate returns the cost rate of the time entry
+func (r Rates) CostRate(te dto.TimeEntry) dto.Rate {
+	var project *dto.Rate
+	if p, ok := r.project(te); ok {
+		project = p.CostRate
+	}
+
+	return r.resolve(te, r.workspace.CostRate, project,
+		func(_, cost *dto.Rate) *dto.Rate { return cost })
+}
+
+// Earning is how much a time entry earned and cost, in cents of the currency
+type Earning struct {
+	Currency string
+	Earned   int64
+	Cost     int64
+	// CostUnknown is set when the cost rate is in another currency than the
+	// hourly rate, so the cost and profit can't be calculated
+	CostUnknown bool
+}
+
+// Profit is the amount earned minus the cost, it is meaningless when
+// CostUnknown is set
+func (e Earning) Profit() int64 {
+	return e.Earned - e.Cost
+}
+
+// Earnings of time entries by their IDs
+type Earnings map[string]Earning
+
+// Amount returns how much the rate charges for the duration, in cents. It is
+// calculated by whole hours and then by seconds, so long durations and high
+// rates don't overflow
+func Amount(r dto.Rate, d time.Duration) int64 {
+	h := int64(d / time.Hour)
+	s := int64((d % time.Hour) / time.Second)
+	return r.Amount*h + r.Amount*s/3600
+}
+
+// Earning calculates the earning of the time entry, only billable time
+// entries earn something. Running time entries are calculated until now
+func (r Rates) Earning(te dto.TimeEntry, now time.Time) Earning {
+	end := now
+	if te.TimeInterval.End != nil {
+		end = *te.TimeInterval.End
+	}
+	d := end.Sub(te.TimeInterval.Start)
+
+	e := Earning{}
+	if te.Billable {
+		h := r.HourlyRate(te)
+		e.Currency = h.Currency
+		e.Earned = Amount(h, d)
+	}
+
+	c := r.CostRate(te)
+	switch {
+	case e.Currency == "":
+		e.Currency = c.Currency
+		e.Cost = Amount(c, d)
+	case isSet(&c) && c.Currency != e.Currency:
+		e.CostUnknown = true
+	default:
+		e.Cost = Amount(c, d)
+	}
+
+	return e
+}
+
+// Earnings calculates the earnings of the time entries
+func (r Rates) Earnings(tes []dto.TimeEntry, now time.Time) Earnings {
+	es := make(Earnings, len(tes))
+	for _, te := range tes {
+		es[te.ID] = r.Earning(te, now)
+	}
+
+	return es
+}
+
+// Totals sums the earnings of the time entries by currency, sorted by it.
+// Time entries without earnings or cost are ignored
+func (es Earnings) Totals(tes []dto.TimeEntry) []Earning {
+	index := map[string]int{}
+	ts := make([]Earning, 0)
+	for _, te := range tes {
+		e, ok := es[te.ID]
+		if !ok || (e.Earned == 0 && e.Cost == 0) {
+			continue
+		}
+
+		i, ok := index[e.Currency]
+		if !ok {
+			i = len(ts)
+			index[e.Currency] = i
+			ts = append(ts, Earning{Currency: e.Currency})
+		}
+
+		ts[i].Earned += e.Earned
+		ts[i].Cost += e.Cost
+		ts[i].CostUnknown = ts[i].CostUnknown || e.CostUnknown
+	}
+
+	sort.Slice(ts, func(i, j int) bool {
+		return ts[i].Currency < ts[j].Currency
+	})
+
+	return ts
+}
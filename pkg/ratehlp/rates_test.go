@@ -0,0 +1,207 @@
+package ratehlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/ratehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func rate(a int64) *dto.Rate {
+	return &dto.Rate{Amount: a, Currency: "USD"}
+}
+
+func newRates() ratehlp.Rates {
+	return ratehlp.New("u1",
+		dto.Workspace{
+			ID:         "w1",
+			HourlyRate: *rate(1000),
+			CostRate:   rate(500),
+			Memberships: []dto.Membership{
+				{UserID: "u2", HourlyRate: rate(9999)},
+				{UserID: "u1", HourlyRate: rate(1500)},
+			},
+		},
+		[]dto.Project{
+			{ID: "without-rates"},
+			{
+				ID:         "with-rates",
+				HourlyRate: *rate(2000),
+				CostRate:   rate(800),
+			},
+			{
+				ID:         "with-membership",
+				HourlyRate: *rate(2000),
+				Memberships: []dto.Membership{
+					{UserID: "u1", HourlyRate: rate(2500), CostRate: rate(900)},
+				},
+				Tasks: []dto.Task{
+					{ID: "with-rate", HourlyRate: rate(3000)},
+					{ID: "without-rate"},
+				},
+			},
+		},
+	)
+}
+
+func TestRates(t *testing.T) {
+	r := newRates()
+
+	tts := map[string]struct {
+		te     dto.TimeEntry
+		hourly int64
+		cost   int64
+	}{
+		"no project uses workspace membership": {
+			te:     dto.TimeEntry{},
+			hourly: 1500,
+			cost:   500,
+		},
+		"project without rates": {
+			te:     dto.TimeEntry{ProjectID: "without-rates"},
+			hourly: 1500,
+			cost:   500,
+		},
+		"project with rates": {
+			te:     dto.TimeEntry{Project: &dto.Project{ID: "with-rates"}},
+			hourly: 2000,
+			cost:   800,
+		},
+		"project membership": {
+			te:     dto.TimeEntry{ProjectID: "with-membership"},
+			hourly: 2500,
+			cost:   900,
+		},
+		"task with rate": {
+			te: dto.TimeEntry{
+				ProjectID: "with-membership",
+				Task:      &dto.Task{ID: "with-rate"},
+			},
+			hourly: 3000,
+			cost:   900,
+		},
+		"task without rate": {
+			te: dto.TimeEntry{
+				ProjectID: "with-membership",
+				Task:      &dto.Task{ID: "without-rate"},
+			},
+			hourly: 2500,
+			cost:   900,
+		},
+		"unknown project uses the time entry one": {
+			te: dto.TimeEntry{Project: &dto.Project{
+				ID: "other", HourlyRate: *rate(4000)}},
+			hourly: 4000,
+			cost:   500,
+		},
+	}
+
+	for name, tt := range tts {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.hourly, r.HourlyRate(tt.te).Amount)
+			assert.Equal(t, tt.cost, r.CostRate(tt.te).Amount)
+		})
+	}
+}
+
+func TestEarnings(t *testing.T) {
+	r := newRates()
+	start := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+
+	tes := []dto.TimeEntry{
+		{
+			ID:           "billable",
+			Billable:     true,
+			ProjectID:    "with-rates",
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+		{
+			ID:           "not-billable",
+			ProjectID:    "with-rates",
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+		{
+			ID:           "running",
+			Billable:     true,
+			TimeInterval: dto.TimeInterval{Start: start},
+		},
+	}
+
+	es := r.Earnings(tes, start.Add(2*time.Hour))
+
+	assert.Equal(t, ratehlp.Earning{
+		Currency: "USD", Earned: 3000, Cost: 1200}, es["billable"])
+	assert.Equal(t, int64(1800), es["billable"].Profit())
+	assert.Equal(t, ratehlp.Earning{
+		Currency: "USD", Earned: 0, Cost: 1200}, es["not-billable"])
+	assert.Equal(t, ratehlp.Earning{
+		Currency: "USD", Earned: 3000, Cost: 1000}, es["running"])
+
+	assert.Equal(t, []ratehlp.Earning{
+		{Currency: "USD", Earned: 6000, Cost: 3400},
+	}, es.Totals(tes))
+}
+
+func TestEarnings_CostInOtherCurrency(t *testing.T) {
+	r := ratehlp.New("u1",
+		dto.Workspace{
+			ID:         "w1",
+			HourlyRate: dto.Rate{Amount: 1000, Currency: "EUR"},
+			CostRate:   rate(500),
+		}, nil)
+
+	start := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	tes := []dto.TimeEntry{
+		{
+			ID:           "billable",
+			Billable:     true,
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+		{
+			ID:           "not-billable",
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+	}
+
+	es := r.Earnings(tes, end)
+	assert.Equal(t, ratehlp.Earning{
+		Currency: "EUR", Earned: 1000, CostUnknown: true}, es["billable"])
+	assert.Equal(t, ratehlp.Earning{
+		Currency: "USD", Cost: 500}, es["not-billable"])
+
+	assert.Equal(t, []ratehlp.Earning{
+		{Currency: "EUR", Earned: 1000, CostUnknown: true},
+		{Currency: "USD", Cost: 500},
+	}, es.Totals(tes))
+}
+
+func TestAmount(t *testing.T) {
+	tts := []struct {
+		name     string
+		amount   int64
+		d        time.Duration
+		expected int64
+	}{
+		{name: "hour and a half", amount: 1000, d: 90 * time.Minute,
+			expected: 1500},
+		{name: "seconds", amount: 3600, d: 61 * time.Second,
+			expected: 61},
+		{name: "large rate", amount: 1000000, d: 3 * time.Hour,
+			expected: 3000000},
+		{name: "long duration", amount: 50000, d: 1000 * time.Hour,
+			expected: 50000000},
+		{name: "large rate and long duration", amount: 1000000,
+			d: 10000*time.Hour + 30*time.Minute, expected: 10000500000},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ratehlp.Amount(
+				dto.Rate{Amount: tt.amount, Currency: "JPY"}, tt.d))
+		})
+	}
+}
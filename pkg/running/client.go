@@ -0,0 +1,121 @@
+package running
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type client struct {
+	api.Client
+	s *Store
+}
+
+// NewClient decorates a api.Client so changes to time entries that may start
+// or stop the one running update the Store, using the time entries returned
+// by the API without requesting them again. Failures of the Store are
+// ignored, as the API is always the source of truth.
+func NewClient(c api.Client, s *Store) api.Client {
+	return &client{Client: c, s: s}
+}
+
+func (c *client) SetDebugLogger(logger api.Logger) api.Client {
+	c.Client.SetDebugLogger(logger)
+	return c
+}
+
+func (c *client) SetInfoLogger(logger api.Logger) api.Client {
+	c.Client.SetInfoLogger(logger)
+	return c
+}
+
+func (c *client) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	c.Client.SetRetryPolicy(p)
+	return c
+}
+
+func (c *client) WithContext(ctx context.Context) api.Client {
+	return &client{Client: c.Client.WithContext(ctx), s: c.s}
+}
+
+// running stores the time entry as the one running, only with the ids of
+// its project, task and tags
+func (c *client) running(t dto.TimeEntryImpl) {
+	te := &dto.TimeEntry{
+		ID:           t.ID,
+		Billable:     t.Billable,
+		Description:  t.Description,
+		IsLocked:     t.IsLocked,
+		ProjectID:    t.ProjectID,
+		TimeInterval: t.TimeInterval,
+		WorkspaceID:  t.WorkspaceID,
+	}
+
+	if t.TaskID != "" {
+		te.Task = &dto.Task{ID: t.TaskID}
+	}
+
+	for _, id := range t.TagIDs {
+		te.Tags = append(te.Tags, dto.Tag{ID: id})
+	}
+
+	_ = c.s.setPartial(t.WorkspaceID, t.UserID, te)
+}
+
+// isRunning returns true if the time entry is the one stored as running
+func (c *client) isRunning(id string) bool {
+	st, ok := c.s.current()
+	return ok && st.TimeEntry != nil && st.TimeEntry.ID == id
+}
+
+func (c *client) CreateTimeEntry(p api.CreateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	te, err := c.Client.CreateTimeEntry(p)
+	if err == nil && p.End == nil {
+		c.running(te)
+	}
+
+	return te, err
+}
+
+func (c *client) UpdateTimeEntry(p api.UpdateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	te, err := c.Client.UpdateTimeEntry(p)
+	if err != nil {
+		return te, err
+	}
+
+	switch {
+	case p.End == nil:
+		c.running(te)
+	case c.isRunning(p.TimeEntryID):
+		_ = c.s.Set(p.Workspace, te.UserID, nil)
+	}
+
+	return te, nil
+}
+
+func (c *client) Out(p api.OutParam) error {
+	err := c.Client.Out(p)
+	if err == nil {
+		_ = c.s.Set(p.Workspace, p.UserID, nil)
+	}
+
+	return err
+}
+
+func (c *client) DeleteTimeEntry(p api.DeleteTimeEntryParam) error {
+	err := c.Client.DeleteTimeEntry(p)
+	if err != nil {
+		return err
+	}
+
+	// nothing is running after deleting the time entry running
+	if st, ok := c.s.current(); ok && st.TimeEntry != nil &&
+		st.TimeEntry.ID == p.TimeEntryID {
+		_ = c.s.Set(st.Workspace, st.UserID, nil)
+	}
+
+	return nil
+}
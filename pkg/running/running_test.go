@@ -0,0 +1,184 @@
+package running_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/running"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	p := filepath.Join(t.TempDir(), "running.json")
+	s := running.NewStore(p, time.Minute,
+		running.WithNow(func() time.Time { return now }))
+
+	_, ok := s.Get("w", "u")
+	assert.False(t, ok, "there is no state before the first set")
+
+	assert.False(t, s.InUse())
+
+	te := &dto.TimeEntry{ID: "te1", Description: "coding"}
+	assert.NoError(t, s.Set("w", "u", te))
+	assert.True(t, s.InUse())
+
+	st, ok := s.Get("w", "u")
+	assert.True(t, ok)
+	assert.Equal(t, te, st.TimeEntry)
+	assert.Equal(t, now, st.FetchedAt)
+
+	_, ok = s.Get("other", "u")
+	assert.False(t, ok, "workspaces should not share the state")
+	_, ok = s.Get("w", "other")
+	assert.False(t, ok, "users should not share the state")
+
+	now = now.Add(time.Minute + time.Second)
+	st, ok = s.Get("w", "u")
+	assert.False(t, ok, "expired state should not be fresh")
+	assert.Equal(t, te, st.TimeEntry, "expired state is still returned")
+
+	assert.NoError(t, s.Set("w", "u", nil))
+	st, ok = s.Get("w", "u")
+	assert.True(t, ok)
+	assert.Nil(t, st.TimeEntry)
+
+	assert.NoError(t, s.Invalidate())
+	_, err := os.Stat(p)
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, s.Invalidate(), "invalidating twice should not fail")
+
+	assert.NoError(t, os.WriteFile(p, []byte("{"), 0o600))
+	_, ok = s.Get("w", "u")
+	assert.False(t, ok, "corrupted files are the same as no state")
+}
+
+func TestClient(t *testing.T) {
+	s := running.NewStore(
+		filepath.Join(t.TempDir(), "running.json"), time.Hour)
+	m := mocks.NewMockClient(t)
+	c := running.NewClient(m, s)
+
+	// fresh states are the ones set by the status command
+	state := func() *dto.TimeEntry {
+		st, ok := s.Get("w", "u")
+		if !assert.True(t, ok, "state should be fresh") {
+			return nil
+		}
+		return st.TimeEntry
+	}
+
+	// changes made by the CLI only know the ids of the related entities
+	partial := func() *dto.TimeEntry {
+		st, ok := s.Get("w", "u")
+		assert.False(t, ok, "partial states should not be fresh")
+		assert.True(t, st.Partial)
+		return st.TimeEntry
+	}
+
+	// starting a time entry uses the one returned, without fetching it
+	start := time.Now().UTC()
+	interval := dto.NewTimeInterval(start, nil)
+	pc := api.CreateTimeEntryParam{Workspace: "w", Start: start}
+	m.EXPECT().CreateTimeEntry(pc).
+		Return(dto.TimeEntryImpl{
+			ID:           "te1",
+			UserID:       "u",
+			WorkspaceID:  "w",
+			ProjectID:    "p1",
+			TaskID:       "tk1",
+			TagIDs:       []string{"tg1"},
+			TimeInterval: interval,
+		}, nil).Once()
+
+	_, err := c.CreateTimeEntry(pc)
+	assert.NoError(t, err)
+	assert.Equal(t, &dto.TimeEntry{
+		ID:           "te1",
+		WorkspaceID:  "w",
+		ProjectID:    "p1",
+		Task:         &dto.Task{ID: "tk1"},
+		Tags:         []dto.Tag{{ID: "tg1"}},
+		TimeInterval: interval,
+	}, partial())
+
+	// creating a finished time entry does not change the one running
+	end := time.Now()
+	pm := api.CreateTimeEntryParam{Workspace: "w", End: &end}
+	m.EXPECT().CreateTimeEntry(pm).
+		Return(dto.TimeEntryImpl{ID: "te2", UserID: "u", WorkspaceID: "w"},
+			nil).Once()
+
+	_, err = c.CreateTimeEntry(pm)
+	assert.NoError(t, err)
+	assert.Equal(t, "te1", partial().ID)
+
+	// changing other time entries does not change the one running
+	pu := api.UpdateTimeEntryParam{Workspace: "w", TimeEntryID: "te2",
+		End: &end}
+	m.EXPECT().UpdateTimeEntry(pu).
+		Return(dto.TimeEntryImpl{ID: "te2", UserID: "u", WorkspaceID: "w"},
+			nil).Once()
+
+	_, err = c.UpdateTimeEntry(pu)
+	assert.NoError(t, err)
+	assert.Equal(t, "te1", partial().ID)
+
+	// but changing it without a end keeps it running
+	pu = api.UpdateTimeEntryParam{Workspace: "w", TimeEntryID: "te1",
+		Description: "coding"}
+	m.EXPECT().UpdateTimeEntry(pu).
+		Return(dto.TimeEntryImpl{ID: "te1", UserID: "u", WorkspaceID: "w",
+			Description: "coding"}, nil).Once()
+
+	_, err = c.UpdateTimeEntry(pu)
+	assert.NoError(t, err)
+	assert.Equal(t, "coding", partial().Description)
+
+	// and setting its end stops it
+	pu = api.UpdateTimeEntryParam{Workspace: "w", TimeEntryID: "te1",
+		End: &end}
+	m.EXPECT().UpdateTimeEntry(pu).
+		Return(dto.TimeEntryImpl{ID: "te1", UserID: "u", WorkspaceID: "w"},
+			nil).Once()
+
+	_, err = c.UpdateTimeEntry(pu)
+	assert.NoError(t, err)
+	assert.Nil(t, state())
+
+	// deleting other time entries does not change the one running
+	te2 := &dto.TimeEntry{ID: "te2"}
+	assert.NoError(t, s.Set("w", "u", te2))
+	m.EXPECT().DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace: "w", TimeEntryID: "te1"}).Return(nil).Once()
+	assert.NoError(t, c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace: "w", TimeEntryID: "te1"}))
+	assert.Equal(t, te2, state())
+
+	// deleting the one running stops it
+	m.EXPECT().DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace: "w", TimeEntryID: "te2"}).Return(nil).Once()
+	assert.NoError(t, c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace: "w", TimeEntryID: "te2"}))
+	assert.Nil(t, state())
+
+	// stopping it
+	assert.NoError(t, s.Set("w", "u", te2))
+	po := api.OutParam{Workspace: "w", UserID: "u", End: end}
+	m.EXPECT().Out(po).Return(nil).Once()
+	assert.NoError(t, c.Out(po))
+	assert.Nil(t, state())
+
+	// failures are not recorded
+	m.EXPECT().CreateTimeEntry(pc).
+		Return(dto.TimeEntryImpl{}, errors.New("http error")).Once()
+	_, err = c.CreateTimeEntry(pc)
+	assert.EqualError(t, err, "http error")
+	assert.Nil(t, state())
+}
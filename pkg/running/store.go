@@ -0,0 +1,167 @@
+// running package keeps a local copy of the time entry running, so it can
+// be shown without calling the API every time (like on shell prompts)
+package running
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// State is the time entry running for the user on the workspace when it
+// was fetched
+type State struct {
+	Workspace string    `json:"workspace"`
+	UserID    string    `json:"userId"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	// TimeEntry running, nil if there is none
+	TimeEntry *dto.TimeEntry `json:"timeEntry"`
+	// Partial is set when the time entry came from a change made by the CLI,
+	// so its project, task and tags are known only by their ids
+	Partial bool `json:"partial,omitempty"`
+}
+
+// Store persists the State on a file, considering it stale after its TTL
+type Store struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+
+	m sync.Mutex
+}
+
+// StoreOption changes optional behaviors of the Store
+type StoreOption func(*Store)
+
+// WithNow sets which function will be used to get the current time
+func WithNow(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore creates a Store that keeps the state on the file path and
+// considers it stale after ttl
+func NewStore(path string, ttl time.Duration, opts ...StoreOption) *Store {
+	s := &Store{
+		path: path,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// Get returns the state of the user on the workspace, if there is one fresh.
+// Partial states are never fresh
+func (s *Store) Get(workspace, userID string) (State, bool) {
+	st, ok := s.current()
+	if !ok || st.Workspace != workspace || st.UserID != userID ||
+		st.Partial || s.now().Sub(st.FetchedAt) > s.ttl {
+		return st, false
+	}
+
+	return st, true
+}
+
+// InUse returns if there is a state stored, which means the "status" command
+// was used
+func (s *Store) InUse() bool {
+	_, err := os.Stat(s.path)
+	return err == nil
+}
+
+// Set stores the time entry as the one running for the user on the
+// workspace, nil if there is none
+func (s *Store) Set(workspace, userID string, te *dto.TimeEntry) error {
+	return s.save(State{
+		Workspace: workspace,
+		UserID:    userID,
+		FetchedAt: s.now(),
+		TimeEntry: te,
+	})
+}
+
+// setPartial stores the time entry running without its related entities
+func (s *Store) setPartial(workspace, userID string, te *dto.TimeEntry) error {
+	return s.save(State{
+		Workspace: workspace,
+		UserID:    userID,
+		FetchedAt: s.now(),
+		TimeEntry: te,
+		Partial:   true,
+	})
+}
+
+func (s *Store) save(st State) error {
+	b, err := json.Marshal(st)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// writing to a temporary file first so prompts don't read a partial file
+	f, err := os.CreateTemp(filepath.Dir(s.path), ".running-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(f.Name(), s.path))
+}
+
+// Invalidate removes the state, so it is fetched again when needed
+func (s *Store) Invalidate() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := os.Remove(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// current returns the state stored, fresh or not
+func (s *Store) current() (State, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return State{}, false
+	}
+
+	var st State
+	if json.Unmarshal(b, &st) != nil {
+		return State{}, false
+	}
+
+	return st, true
+}
@@ -47,3 +47,30 @@ func GetTagsByName(
 
 	return tags, g.Wait()
 }
+
+// GetTagByName will look for a tag that the id or name Contains the string on
+// tag parameter
+func GetTagByName(
+	c api.Client,
+	workspace string,
+	tag string,
+) (string, error) {
+	return findByName(
+		tag,
+		"tag", func() ([]named, error) {
+			ts, err := c.GetTags(api.GetTagsParam{
+				Workspace:       workspace,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return []named{}, err
+			}
+
+			ns := make([]named, len(ts))
+			for i := 0; i < len(ns); i++ {
+				ns[i] = ts[i]
+			}
+			return ns, nil
+		},
+	)
+}
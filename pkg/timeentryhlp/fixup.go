@@ -0,0 +1,248 @@
+package timeentryhlp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Change is a new interval for a time entry, or its removal when Delete is
+// true
+type Change struct {
+	TimeEntry dto.TimeEntry
+	Interval
+	Delete bool
+	// Reason is why the time entry is changed
+	Reason string
+}
+
+// Merges returns the changes to combine time entries with the same project,
+// task, description, tags, billable status and custom fields that are apart
+// by maxGap or less into the first of them. Running and locked time entries
+// are not merged
+func Merges(tes []dto.TimeEntry, maxGap time.Duration) []Change {
+	tes = sortByStart(tes)
+
+	var cs []Change
+	for i := 0; i < len(tes); i++ {
+		first := tes[i]
+		if first.TimeInterval.End == nil || first.IsLocked {
+			continue
+		}
+
+		end := *first.TimeInterval.End
+		var merged []Change
+		for ; i+1 < len(tes); i++ {
+			next := tes[i+1]
+			if next.TimeInterval.End == nil || next.IsLocked ||
+				next.TimeInterval.Start.Sub(end) > maxGap ||
+				!sameActivity(first, next) {
+				break
+			}
+
+			merged = append(merged, Change{
+				TimeEntry: next,
+				Interval: Interval{
+					Start: next.TimeInterval.Start,
+					End:   *next.TimeInterval.End,
+				},
+				Delete: true,
+				Reason: "merged into " + first.ID,
+			})
+
+			if next.TimeInterval.End.After(end) {
+				end = *next.TimeInterval.End
+			}
+		}
+
+		if len(merged) == 0 {
+			continue
+		}
+
+		cs = append(cs, Change{
+			TimeEntry: first,
+			Interval:  Interval{Start: first.TimeInterval.Start, End: end},
+			Reason:    fmt.Sprintf("merged with %d time entries", len(merged)),
+		})
+		cs = append(cs, merged...)
+	}
+
+	return cs
+}
+
+func sameActivity(a, b dto.TimeEntry) bool {
+	return a.ProjectID == b.ProjectID &&
+		taskID(a) == taskID(b) &&
+		a.Billable == b.Billable &&
+		strings.EqualFold(
+			strings.TrimSpace(a.Description),
+			strings.TrimSpace(b.Description)) &&
+		sameValues(tagIDs(a), tagIDs(b)) &&
+		sameValues(customFieldValues(a), customFieldValues(b))
+}
+
+func tagIDs(te dto.TimeEntry) []string {
+	ids := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		ids[i] = te.Tags[i].ID
+	}
+
+	return ids
+}
+
+// customFieldValues returns the custom fields set on the time entry as
+// "id=value"
+func customFieldValues(te dto.TimeEntry) []string {
+	vs := make([]string, 0, len(te.CustomFields))
+	for _, cf := range te.CustomFields {
+		if v := cf.ValueAsString(); v != "" {
+			vs = append(vs, cf.CustomFieldID+"="+v)
+		}
+	}
+
+	return vs
+}
+
+// sameValues returns if both lists have the same values, in any order
+func sameValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func taskID(te dto.TimeEntry) string {
+	if te.Task == nil {
+		return ""
+	}
+
+	return te.Task.ID
+}
+
+// Trims returns the changes to remove the overlaps between time entries,
+// moving the start of a time entry to the end of the ones before it. Time
+// entries entirely inside others and locked ones are not changed. Running
+// time entries are considered to end at until
+func Trims(tes []dto.TimeEntry, until time.Time) []Change {
+	tes = sortByStart(tes)
+
+	var cs []Change
+	var end time.Time
+	prev := ""
+	for _, te := range tes {
+		s := te.TimeInterval.Start
+		e := entryEnd(te, until)
+
+		if prev != "" && s.Before(end) && e.After(end) && !te.IsLocked {
+			cs = append(cs, Change{
+				TimeEntry: te,
+				Interval:  Interval{Start: end, End: e},
+				Reason:    "overlapped " + prev,
+			})
+		}
+
+		if prev == "" || e.After(end) {
+			end = e
+			prev = te.ID
+		}
+	}
+
+	return cs
+}
+
+// Round modes of dto.Round
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// ParseRound returns the mode and step of the rounding settings of a
+// workspace
+func ParseRound(r dto.Round) (string, time.Duration, error) {
+	m, err := strconv.Atoi(strings.TrimSpace(r.Minutes))
+	if err != nil || m <= 0 {
+		return "", 0, fmt.Errorf(
+			"round minutes \"%s\" is invalid", r.Minutes)
+	}
+
+	mode := strings.ToLower(r.Round)
+	switch {
+	case strings.Contains(mode, RoundUp):
+		mode = RoundUp
+	case strings.Contains(mode, RoundDown):
+		mode = RoundDown
+	case strings.Contains(mode, RoundNearest):
+		mode = RoundNearest
+	default:
+		return "", 0, fmt.Errorf("round mode \"%s\" is invalid", r.Round)
+	}
+
+	return mode, time.Duration(m) * time.Minute, nil
+}
+
+// Snaps returns the changes to round the start and end of the time entries
+// to multiples of step, counting from the start of their days. Running time
+// entries only have their start rounded; time entries that would be left
+// empty and locked ones are not changed
+func Snaps(tes []dto.TimeEntry, mode string, step time.Duration) []Change {
+	var cs []Change
+	for _, te := range sortByStart(tes) {
+		if te.IsLocked {
+			continue
+		}
+
+		s := te.TimeInterval.Start
+		i := Interval{Start: snap(s, mode, step)}
+
+		if te.TimeInterval.End != nil {
+			i.End = snap(*te.TimeInterval.End, mode, step)
+			if !i.End.After(i.Start) ||
+				(i.Start.Equal(s) && i.End.Equal(*te.TimeInterval.End)) {
+				continue
+			}
+		} else if i.Start.Equal(s) {
+			continue
+		}
+
+		cs = append(cs, Change{
+			TimeEntry: te,
+			Interval:  i,
+			Reason:    "rounded " + mode + " to " + step.String(),
+		})
+	}
+
+	return cs
+}
+
+func snap(t time.Time, mode string, step time.Duration) time.Time {
+	t = t.In(time.Local)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	d := t.Sub(day)
+
+	r := d.Truncate(step)
+	switch {
+	case r == d:
+	case mode == RoundUp:
+		r += step
+	case mode == RoundNearest && d-r >= step/2:
+		r += step
+	}
+
+	return day.Add(r)
+}
@@ -0,0 +1,161 @@
+package timeentryhlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixupAt(h, m int) time.Time {
+	return time.Date(2026, 10, 14, h, m, 0, 0, time.Local)
+}
+
+func fixupTE(id string, s, e time.Time) dto.TimeEntry {
+	return dto.TimeEntry{
+		ID:           id,
+		ProjectID:    "p1",
+		Description:  "coding",
+		TimeInterval: dto.NewTimeInterval(s, &e),
+	}
+}
+
+func changes(cs []timeentryhlp.Change) []string {
+	s := make([]string, len(cs))
+	for i, c := range cs {
+		s[i] = c.TimeEntry.ID + " " + c.Start.Local().Format("15:04") + "-"
+		if !c.End.IsZero() {
+			s[i] += c.End.Local().Format("15:04")
+		}
+		if c.Delete {
+			s[i] = "-" + s[i]
+		}
+	}
+
+	return s
+}
+
+func TestMerges(t *testing.T) {
+	other := fixupTE("other", fixupAt(12, 0), fixupAt(13, 0))
+	other.Description = "meeting"
+
+	locked := fixupTE("locked", fixupAt(15, 0), fixupAt(16, 0))
+	locked.IsLocked = true
+
+	tagged := func(id string, s, e time.Time, tags ...string) dto.TimeEntry {
+		te := fixupTE(id, s, e)
+		for _, t := range tags {
+			te.Tags = append(te.Tags, dto.Tag{ID: t})
+		}
+		return te
+	}
+
+	billable := fixupTE("billable", fixupAt(19, 0), fixupAt(20, 0))
+	billable.Billable = true
+
+	field := func(id string, s, e time.Time, v string) dto.TimeEntry {
+		te := fixupTE(id, s, e)
+		te.CustomFields = []dto.CustomField{{CustomFieldID: "cf", Value: v}}
+		return te
+	}
+
+	cs := timeentryhlp.Merges([]dto.TimeEntry{
+		fixupTE("b", fixupAt(10, 0), fixupAt(11, 0)),
+		fixupTE("a", fixupAt(9, 0), fixupAt(10, 0)),
+		fixupTE("c", fixupAt(11, 5), fixupAt(12, 0)),
+		other,
+		fixupTE("d", fixupAt(13, 0), fixupAt(14, 0)),
+		fixupTE("e", fixupAt(14, 0), fixupAt(15, 0)),
+		locked,
+		fixupTE("f", fixupAt(16, 0), fixupAt(17, 0)),
+		tagged("g", fixupAt(17, 0), fixupAt(18, 0), "t1", "t2"),
+		tagged("h", fixupAt(18, 0), fixupAt(18, 30), "t2", "t1"),
+		tagged("i", fixupAt(18, 30), fixupAt(19, 0), "t1"),
+		billable,
+		field("j", fixupAt(20, 0), fixupAt(21, 0), "x"),
+		field("k", fixupAt(21, 0), fixupAt(22, 0), "y"),
+	}, 5*time.Minute)
+
+	assert.Equal(t, []string{
+		"a 09:00-12:00", "-b 10:00-11:00", "-c 11:05-12:00",
+		"d 13:00-15:00", "-e 14:00-15:00",
+		"g 17:00-18:30", "-h 18:00-18:30",
+	}, changes(cs))
+	assert.Equal(t, "merged into a", cs[1].Reason)
+}
+
+func TestTrims(t *testing.T) {
+	running := dto.TimeEntry{ID: "running",
+		TimeInterval: dto.NewTimeInterval(fixupAt(13, 30), nil)}
+
+	cs := timeentryhlp.Trims([]dto.TimeEntry{
+		fixupTE("long", fixupAt(9, 0), fixupAt(12, 0)),
+		fixupTE("inside", fixupAt(10, 0), fixupAt(10, 30)),
+		fixupTE("crossing", fixupAt(11, 30), fixupAt(14, 0)),
+		running,
+	}, fixupAt(15, 0))
+
+	assert.Equal(t, []string{
+		"crossing 12:00-14:00", "running 14:00-15:00",
+	}, changes(cs))
+	assert.Equal(t, "overlapped long", cs[0].Reason)
+	assert.Equal(t, "overlapped crossing", cs[1].Reason)
+}
+
+func TestParseRound(t *testing.T) {
+	m, d, err := timeentryhlp.ParseRound(
+		dto.Round{Minutes: "15", Round: "Round to nearest"})
+	assert.NoError(t, err)
+	assert.Equal(t, timeentryhlp.RoundNearest, m)
+	assert.Equal(t, 15*time.Minute, d)
+
+	m, _, _ = timeentryhlp.ParseRound(
+		dto.Round{Minutes: "15", Round: "Round up to"})
+	assert.Equal(t, timeentryhlp.RoundUp, m)
+
+	_, _, err = timeentryhlp.ParseRound(dto.Round{Minutes: "x", Round: "up"})
+	assert.EqualError(t, err, "round minutes \"x\" is invalid")
+
+	_, _, err = timeentryhlp.ParseRound(dto.Round{Minutes: "5", Round: "?"})
+	assert.EqualError(t, err, "round mode \"?\" is invalid")
+}
+
+func TestSnaps(t *testing.T) {
+	tes := []dto.TimeEntry{
+		fixupTE("a", fixupAt(9, 3), fixupAt(10, 26)),
+		fixupTE("exact", fixupAt(11, 0), fixupAt(11, 15)),
+		fixupTE("short", fixupAt(12, 1), fixupAt(12, 5)),
+		{ID: "running",
+			TimeInterval: dto.NewTimeInterval(fixupAt(13, 8), nil)},
+	}
+
+	tts := []struct {
+		mode     string
+		expected []string
+	}{
+		{
+			mode: timeentryhlp.RoundNearest,
+			expected: []string{
+				"a 09:00-10:30", "running 13:15-"},
+		},
+		{
+			mode: timeentryhlp.RoundUp,
+			expected: []string{
+				"a 09:15-10:30", "running 13:15-"},
+		},
+		{
+			mode: timeentryhlp.RoundDown,
+			expected: []string{
+				"a 09:00-10:15", "running 13:00-"},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.mode, func(t *testing.T) {
+			assert.Equal(t, tt.expected, changes(
+				timeentryhlp.Snaps(tes, tt.mode, 15*time.Minute)))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package timeentryhlp
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Interval is a period of time, like a gap between time entries
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Holes returns the intervals between from and to that are not covered by
+// any of the time entries, using the location of from. Running time entries
+// are considered to end at to
+func Holes(tes []dto.TimeEntry, from, to time.Time) []Interval {
+	tes = sortByStart(tes)
+
+	var hs []Interval
+	l := from.Location()
+	cursor := from
+	for _, te := range tes {
+		s := te.TimeInterval.Start
+		if s.After(to) {
+			s = to
+		}
+
+		if s.After(cursor) {
+			hs = append(hs, Interval{Start: cursor.In(l), End: s.In(l)})
+		}
+
+		if e := entryEnd(te, to); e.After(cursor) {
+			cursor = e
+		}
+	}
+
+	if to.After(cursor) {
+		hs = append(hs, Interval{Start: cursor.In(l), End: to.In(l)})
+	}
+
+	return hs
+}
+
+// Gaps returns the intervals of the day that must be filled for the time
+// tracked to reach the expected duration. The gaps are taken between the
+// time entries, starting at the start of the workday and never after until,
+// using the location of the workday start
+func Gaps(
+	tes []dto.TimeEntry,
+	workdayStart time.Time,
+	expected time.Duration,
+	until time.Time,
+) []Interval {
+	missing := expected
+	for _, te := range tes {
+		missing -= entryEnd(te, until).Sub(te.TimeInterval.Start)
+	}
+
+	var gs []Interval
+	for _, h := range Holes(tes, workdayStart, until) {
+		if missing <= 0 {
+			break
+		}
+
+		if h.End.Sub(h.Start) > missing {
+			h.End = h.Start.Add(missing)
+		}
+
+		gs = append(gs, h)
+		missing -= h.End.Sub(h.Start)
+	}
+
+	return gs
+}
+
+func sortByStart(tes []dto.TimeEntry) []dto.TimeEntry {
+	tes = append([]dto.TimeEntry{}, tes...)
+	sort.SliceStable(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+	})
+
+	return tes
+}
+
+func entryEnd(te dto.TimeEntry, until time.Time) time.Time {
+	if te.TimeInterval.End == nil {
+		return until
+	}
+
+	return *te.TimeInterval.End
+}
@@ -0,0 +1,101 @@
+package timeentryhlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaps(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2026, 10, 14, h, m, 0, 0, time.UTC)
+	}
+	te := func(s, e time.Time) dto.TimeEntry {
+		return dto.TimeEntry{TimeInterval: dto.NewTimeInterval(s, &e)}
+	}
+	running := dto.TimeEntry{
+		TimeInterval: dto.NewTimeInterval(at(16, 0), nil)}
+
+	tts := []struct {
+		name     string
+		tes      []dto.TimeEntry
+		expected time.Duration
+		until    time.Time
+		gaps     []timeentryhlp.Interval
+	}{
+		{
+			name:     "empty day",
+			expected: 8 * time.Hour,
+			until:    at(23, 59),
+			gaps:     []timeentryhlp.Interval{{Start: at(9, 0), End: at(17, 0)}},
+		},
+		{
+			name: "between time entries",
+			tes: []dto.TimeEntry{
+				te(at(13, 0), at(15, 0)),
+				te(at(8, 0), at(10, 0)),
+			},
+			expected: 9 * time.Hour,
+			until:    at(23, 59),
+			gaps: []timeentryhlp.Interval{
+				{Start: at(10, 0), End: at(13, 0)},
+				{Start: at(15, 0), End: at(17, 0)},
+			},
+		},
+		{
+			name:     "already complete",
+			tes:      []dto.TimeEntry{te(at(9, 0), at(18, 0))},
+			expected: 8 * time.Hour,
+			until:    at(23, 59),
+		},
+		{
+			name:     "not after until",
+			tes:      []dto.TimeEntry{te(at(9, 0), at(10, 0))},
+			expected: 8 * time.Hour,
+			until:    at(12, 30),
+			gaps:     []timeentryhlp.Interval{{Start: at(10, 0), End: at(12, 30)}},
+		},
+		{
+			name:     "running until now",
+			tes:      []dto.TimeEntry{running},
+			expected: 4 * time.Hour,
+			until:    at(17, 0),
+			gaps:     []timeentryhlp.Interval{{Start: at(9, 0), End: at(12, 0)}},
+		},
+	}
+
+	for i := range tts {
+		tt := tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.gaps, timeentryhlp.Gaps(
+				tt.tes, at(9, 0), tt.expected, tt.until))
+		})
+	}
+}
+
+func TestHoles(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2026, 10, 14, h, m, 0, 0, time.UTC)
+	}
+	te := func(s, e time.Time) dto.TimeEntry {
+		return dto.TimeEntry{TimeInterval: dto.NewTimeInterval(s, &e)}
+	}
+
+	assert.Equal(t, []timeentryhlp.Interval{
+		{Start: at(9, 0), End: at(10, 0)},
+		{Start: at(12, 0), End: at(12, 30)},
+		{Start: at(17, 0), End: at(18, 0)},
+	}, timeentryhlp.Holes([]dto.TimeEntry{
+		te(at(12, 30), at(17, 0)),
+		te(at(10, 0), at(11, 0)),
+		te(at(10, 30), at(12, 0)),
+		te(at(19, 0), at(20, 0)),
+	}, at(9, 0), at(18, 0)))
+
+	assert.Nil(t, timeentryhlp.Holes([]dto.TimeEntry{
+		te(at(8, 0), at(19, 0)),
+	}, at(9, 0), at(18, 0)))
+}
@@ -0,0 +1,45 @@
+package timeentryhlp
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Overlap is a pair of time entries sharing part of their intervals, First
+// is the one that started earlier
+type Overlap struct {
+	First  dto.TimeEntry
+	Second dto.TimeEntry
+	Interval
+}
+
+// Overlaps returns every pair of time entries that overlap. Running time
+// entries are considered to end at until
+func Overlaps(tes []dto.TimeEntry, until time.Time) []Overlap {
+	tes = sortByStart(tes)
+
+	var ols []Overlap
+	for i := range tes {
+		e := entryEnd(tes[i], until)
+		for j := i + 1; j < len(tes); j++ {
+			s := tes[j].TimeInterval.Start
+			if !s.Before(e) {
+				break
+			}
+
+			oe := entryEnd(tes[j], until)
+			if oe.After(e) {
+				oe = e
+			}
+
+			ols = append(ols, Overlap{
+				First:    tes[i],
+				Second:   tes[j],
+				Interval: Interval{Start: s, End: oe},
+			})
+		}
+	}
+
+	return ols
+}
@@ -0,0 +1,46 @@
+package timeentryhlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlaps(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2026, 10, 14, h, m, 0, 0, time.UTC)
+	}
+	te := func(id string, s, e time.Time) dto.TimeEntry {
+		return dto.TimeEntry{ID: id, TimeInterval: dto.NewTimeInterval(s, &e)}
+	}
+
+	long := te("long", at(9, 0), at(12, 0))
+	inside := te("inside", at(10, 0), at(10, 30))
+	crossing := te("crossing", at(11, 30), at(13, 0))
+	after := te("after", at(13, 0), at(14, 0))
+	running := dto.TimeEntry{
+		ID: "running", TimeInterval: dto.NewTimeInterval(at(13, 30), nil)}
+
+	ols := timeentryhlp.Overlaps(
+		[]dto.TimeEntry{after, crossing, inside, long, running}, at(15, 0))
+	if !assert.Len(t, ols, 3) {
+		return
+	}
+
+	assert.Equal(t, "long", ols[0].First.ID)
+	assert.Equal(t, "inside", ols[0].Second.ID)
+	assert.Equal(t, timeentryhlp.Interval{Start: at(10, 0), End: at(10, 30)},
+		ols[0].Interval)
+
+	assert.Equal(t, "crossing", ols[1].Second.ID)
+	assert.Equal(t, timeentryhlp.Interval{Start: at(11, 30), End: at(12, 0)},
+		ols[1].Interval)
+
+	assert.Equal(t, "after", ols[2].First.ID)
+	assert.Equal(t, "running", ols[2].Second.ID)
+	assert.Equal(t, timeentryhlp.Interval{Start: at(13, 30), End: at(14, 0)},
+		ols[2].Interval)
+}
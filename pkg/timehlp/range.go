@@ -1,6 +1,11 @@
 package timehlp
 
-import "time"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
 
 // GetMonthRange given a time it returns the first and last date of a month
 func GetMonthRange(ref time.Time) (first, last time.Time) {
@@ -13,7 +18,58 @@ func GetMonthRange(ref time.Time) (first, last time.Time) {
 // GetWeekRange given a time it returns the first and last date of a week
 func GetWeekRange(ref time.Time) (first, last time.Time) {
 	first = ref.AddDate(0, 0, int(ref.Weekday())*-1)
-	last = first.AddDate(0, 0, 7)
+	last = first.AddDate(0, 0, 6)
 
 	return
 }
+
+// NamedRanges are the ranges accepted by GetNamedRange, with their
+// descriptions
+var NamedRanges = map[string]string{
+	"today":      "only today",
+	"yesterday":  "only yesterday",
+	"this-week":  "from sunday to saturday of the current week",
+	"last-week":  "from sunday to saturday of the last week",
+	"next-week":  "from sunday to saturday of the next week",
+	"this-month": "all days of the current month",
+	"last-month": "all days of the last month",
+	"next-month": "all days of the next month",
+}
+
+// GetNamedRange returns the first and last dates of a range by its name
+// (see NamedRanges), relative to the reference date
+func GetNamedRange(name string, ref time.Time) (first, last time.Time, err error) {
+	switch name {
+	case "today":
+		return ref, ref, nil
+	case "yesterday":
+		y := ref.AddDate(0, 0, -1)
+		return y, y, nil
+	case "this-week":
+		first, last = GetWeekRange(ref)
+	case "last-week":
+		first, last = GetWeekRange(ref.AddDate(0, 0, -7))
+	case "next-week":
+		first, last = GetWeekRange(ref.AddDate(0, 0, 7))
+	case "this-month":
+		first, last = GetMonthRange(ref)
+	case "last-month":
+		first, _ = GetMonthRange(ref)
+		first, last = GetMonthRange(first.AddDate(0, -1, 0))
+	case "next-month":
+		first, _ = GetMonthRange(ref)
+		first, last = GetMonthRange(first.AddDate(0, 1, 0))
+	default:
+		names := make([]string, 0, len(NamedRanges))
+		for n := range NamedRanges {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		return first, last, fmt.Errorf(
+			"range \"%s\" is invalid, use one of: %s",
+			name, strings.Join(names, ", "))
+	}
+
+	return first, last, nil
+}
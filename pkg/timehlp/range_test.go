@@ -0,0 +1,53 @@
+package timehlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWeekRange(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	for d := 11; d <= 17; d++ {
+		first, last := timehlp.GetWeekRange(day(d))
+		assert.Equal(t, day(11), first, "first day of %s", day(d))
+		assert.Equal(t, day(17), last, "last day of %s", day(d))
+	}
+}
+
+func TestGetNamedRange(t *testing.T) {
+	ref := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tts := map[string][2]time.Time{
+		"today":      {ref, ref},
+		"yesterday":  {day(10, 13), day(10, 13)},
+		"this-week":  {day(10, 11), day(10, 17)},
+		"last-week":  {day(10, 4), day(10, 10)},
+		"next-week":  {day(10, 18), day(10, 24)},
+		"this-month": {day(10, 1), day(10, 31)},
+		"last-month": {day(9, 1), day(9, 30)},
+		"next-month": {day(11, 1), day(11, 30)},
+	}
+
+	for name, r := range tts {
+		t.Run(name, func(t *testing.T) {
+			first, last, err := timehlp.GetNamedRange(name, ref)
+			assert.NoError(t, err)
+			assert.Equal(t, r[0], first)
+			assert.Equal(t, r[1], last)
+		})
+	}
+
+	_, _, err := timehlp.GetNamedRange("forever", ref)
+	assert.EqualError(t, err, `range "forever" is invalid, use one of: `+
+		"last-month, last-week, next-month, next-week, this-month, "+
+		"this-week, today, yesterday")
+}
@@ -0,0 +1,162 @@
+package worktime
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const icsDateFormat = "20060102"
+
+// ParseICS reads the all-day events of an iCalendar file (like the public
+// holidays calendars) as days off, one for each day of the event. Events
+// with times or recurrence rules can't be mapped to days off, so they are
+// skipped and only counted
+func ParseICS(r io.Reader) (ts []TimeOff, skipped int, err error) {
+	lines, err := unfoldICS(r)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "read ics file")
+	}
+
+	var (
+		inEvent bool
+		ev      map[string]icsProp
+	)
+	for i, l := range lines {
+		name, p, ok := parseICSLine(l)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && p.value == "VEVENT":
+			inEvent = true
+			ev = map[string]icsProp{}
+		case name == "END" && p.value == "VEVENT":
+			if !inEvent {
+				return nil, 0, errors.Errorf(
+					"read ics file: line %d: END without BEGIN", i+1)
+			}
+			inEvent = false
+
+			days, ok, err := icsEventDays(ev)
+			if err != nil {
+				return nil, 0, errors.Wrapf(err, "read ics file: line %d", i+1)
+			}
+
+			if !ok {
+				skipped++
+				continue
+			}
+
+			ts = append(ts, days...)
+		case inEvent:
+			if _, ok := ev[name]; !ok {
+				ev[name] = p
+			}
+		}
+	}
+
+	return ts, skipped, nil
+}
+
+type icsProp struct {
+	params map[string]string
+	value  string
+}
+
+// unfoldICS joins the lines continued with a leading space or tab
+func unfoldICS(r io.Reader) ([]string, error) {
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		l := strings.TrimRight(s.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(l, " ") ||
+			strings.HasPrefix(l, "\t")) {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+
+		lines = append(lines, l)
+	}
+
+	return lines, s.Err()
+}
+
+// parseICSLine splits a line like "DTSTART;VALUE=DATE:20261225" into its
+// name, params and value
+func parseICSLine(l string) (string, icsProp, bool) {
+	head, value, ok := strings.Cut(l, ":")
+	if !ok {
+		return "", icsProp{}, false
+	}
+
+	parts := strings.Split(head, ";")
+	p := icsProp{params: map[string]string{}, value: value}
+	for _, param := range parts[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(k)] = v
+	}
+
+	return strings.ToUpper(parts[0]), p, true
+}
+
+// icsEventDays returns the days of an all-day event, or false if the event
+// is not one
+func icsEventDays(ev map[string]icsProp) ([]TimeOff, bool, error) {
+	if _, ok := ev["RRULE"]; ok {
+		return nil, false, nil
+	}
+
+	start, ok := ev["DTSTART"]
+	if !ok || !isICSDate(start) {
+		return nil, false, nil
+	}
+
+	first, err := time.Parse(icsDateFormat, start.value)
+	if err != nil {
+		return nil, false, errors.Errorf(
+			"DTSTART \"%s\" is invalid", start.value)
+	}
+
+	last := first
+	if end, ok := ev["DTEND"]; ok {
+		if !isICSDate(end) {
+			return nil, false, nil
+		}
+
+		e, err := time.Parse(icsDateFormat, end.value)
+		if err != nil {
+			return nil, false, errors.Errorf(
+				"DTEND \"%s\" is invalid", end.value)
+		}
+
+		// the end of all-day events is exclusive
+		if e.After(first) {
+			last = e.AddDate(0, 0, -1)
+		}
+	}
+
+	name := unescapeICS(ev["SUMMARY"].value)
+	var ts []TimeOff
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		ts = append(ts, TimeOff{Date: d.Format(DateFormat), Name: name})
+	}
+
+	return ts, true, nil
+}
+
+func isICSDate(p icsProp) bool {
+	return p.params["VALUE"] == "DATE" ||
+		(p.params["VALUE"] == "" && len(p.value) == len(icsDateFormat))
+}
+
+var icsUnescaper = strings.NewReplacer(
+	`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeICS(s string) string {
+	return strings.TrimSpace(icsUnescaper.Replace(s))
+}
@@ -0,0 +1,44 @@
+package worktime
+
+import (
+	"time"
+)
+
+// Targets tells how long the user is expected to work on each day
+type Targets struct {
+	// Hours expected on each weekday
+	Hours func(time.Weekday) time.Duration
+	// Calendar with the days off, may be nil
+	Calendar *Calendar
+}
+
+// Expected returns how long the user is expected to work on the day
+func (t Targets) Expected(day time.Time) (time.Duration, error) {
+	usual := t.Hours(day.Weekday())
+	if t.Calendar == nil {
+		return usual, nil
+	}
+
+	off, ok, err := t.Calendar.Get(day)
+	if err != nil || !ok {
+		return usual, err
+	}
+
+	return off.Expected(usual), nil
+}
+
+// ExpectedBetween returns how long the user is expected to work from the
+// first to the last day, including both
+func (t Targets) ExpectedBetween(first, last time.Time) (time.Duration, error) {
+	var sum time.Duration
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		e, err := t.Expected(d)
+		if err != nil {
+			return 0, err
+		}
+
+		sum += e
+	}
+
+	return sum, nil
+}
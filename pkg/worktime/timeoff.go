@@ -0,0 +1,223 @@
+// worktime package calculates how long the user is expected to work on each
+// day, taking into account the days off kept on a local file
+package worktime
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// DateFormat is the format of the dates on the time off file
+const DateFormat = "2006-01-02"
+
+// TimeOff is a day the user is not expected to work, or expected to work
+// less than usual
+type TimeOff struct {
+	Date string `yaml:"date" json:"date"`
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Hours the user is expected to work on the day (like "4h"), none if
+	// not set
+	Hours string `yaml:"hours,omitempty" json:"hours,omitempty"`
+	// Half is true when the user is expected to work half of the usual hours
+	Half bool `yaml:"half,omitempty" json:"half,omitempty"`
+}
+
+// Validate checks if the date and hours are valid
+func (t TimeOff) Validate() error {
+	if _, err := time.Parse(DateFormat, t.Date); err != nil {
+		return fmt.Errorf(
+			"date \"%s\" is invalid, use a date like \"2026-12-25\"", t.Date)
+	}
+
+	if t.Hours == "" {
+		return nil
+	}
+
+	if t.Half {
+		return errors.New("half and hours can't be set on the same day")
+	}
+
+	if d, err := time.ParseDuration(t.Hours); err != nil || d < 0 {
+		return fmt.Errorf(
+			"hours \"%s\" is invalid, use a duration like \"4h\"", t.Hours)
+	}
+
+	return nil
+}
+
+// Expected returns how long the user is expected to work on the day, given
+// how long is expected on it usually
+func (t TimeOff) Expected(usual time.Duration) time.Duration {
+	if t.Half {
+		return usual / 2
+	}
+
+	d, _ := time.ParseDuration(t.Hours)
+	return d
+}
+
+// FullDay returns true if the user is not expected to work on the day
+func (t TimeOff) FullDay() bool {
+	return t.Hours == "" && !t.Half
+}
+
+// Calendar keeps the days off of the user on a YAML file
+type Calendar struct {
+	path string
+	mu   sync.Mutex
+	days map[string]TimeOff
+}
+
+// NewCalendar returns a calendar stored at the file path, which is created
+// when the first day off is saved
+func NewCalendar(path string) *Calendar {
+	return &Calendar{path: path}
+}
+
+func (c *Calendar) load() error {
+	if c.days != nil {
+		return nil
+	}
+
+	c.days = map[string]TimeOff{}
+	b, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "open time off file")
+	}
+
+	ts, err := ParseYAML(b)
+	if err != nil {
+		return errors.Wrap(err, "read time off file")
+	}
+
+	for _, t := range ts {
+		c.days[t.Date] = t
+	}
+
+	return nil
+}
+
+// ParseYAML reads a YAML list of days off, like the ones on the time off file
+func ParseYAML(b []byte) ([]TimeOff, error) {
+	var ts []TimeOff
+	if err := yaml.Unmarshal(b, &ts); err != nil {
+		return nil, err
+	}
+
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ts, nil
+}
+
+// Get returns the time off on the day, if there is one
+func (c *Calendar) Get(day time.Time) (TimeOff, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return TimeOff{}, false, err
+	}
+
+	t, ok := c.days[day.Format(DateFormat)]
+	return t, ok, nil
+}
+
+// All returns the days off, sorted by date
+func (c *Calendar) All() ([]TimeOff, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	return c.sorted(), nil
+}
+
+func (c *Calendar) sorted() []TimeOff {
+	ts := make([]TimeOff, 0, len(c.days))
+	for _, t := range c.days {
+		ts = append(ts, t)
+	}
+
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Date < ts[j].Date })
+	return ts
+}
+
+// DayOff returns true if the user has the whole day off
+func (c *Calendar) DayOff(day time.Time) (bool, error) {
+	t, ok, err := c.Get(day)
+	return ok && t.FullDay(), err
+}
+
+// Add saves the days off on the file, replacing the ones on the same dates
+func (c *Calendar) Add(ts ...TimeOff) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return err
+	}
+
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range ts {
+		c.days[t.Date] = t
+	}
+
+	return c.save()
+}
+
+// Remove deletes the days off on the dates from the file
+func (c *Calendar) Remove(dates ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return err
+	}
+
+	for _, d := range dates {
+		if _, ok := c.days[d]; !ok {
+			return errors.New("there is no time off on " + d)
+		}
+	}
+
+	for _, d := range dates {
+		delete(c.days, d)
+	}
+
+	return c.save()
+}
+
+func (c *Calendar) save() error {
+	b, err := yaml.Marshal(c.sorted())
+	if err != nil {
+		return errors.Wrap(err, "write time off file")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return errors.Wrap(err, "write time off file")
+	}
+
+	return errors.Wrap(os.WriteFile(c.path, b, 0600), "write time off file")
+}
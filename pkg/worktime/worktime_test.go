@@ -0,0 +1,199 @@
+package worktime_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/worktime"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCalendar(t *testing.T, content string) *worktime.Calendar {
+	p := filepath.Join(t.TempDir(), "time-off.yaml")
+	if content != "" {
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return worktime.NewCalendar(p)
+}
+
+func TestTargets(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 12, d, 0, 0, 0, 0, time.Local)
+	}
+
+	ts := worktime.Targets{
+		Hours: func(wd time.Weekday) time.Duration {
+			switch wd {
+			case time.Saturday, time.Sunday:
+				return 0
+			case time.Friday:
+				return 4 * time.Hour
+			default:
+				return 8 * time.Hour
+			}
+		},
+		Calendar: newCalendar(t, `
+- date: 2026-12-25
+  name: Christmas
+- date: 2026-12-24
+  hours: 4h
+- date: 2026-12-26
+  hours: 2h
+- date: 2026-12-18
+  half: true
+`),
+	}
+
+	tts := []struct {
+		day      time.Time
+		expected time.Duration
+	}{
+		{day(23), 8 * time.Hour},
+		{day(24), 4 * time.Hour},
+		{day(25), 0},
+		{day(26), 2 * time.Hour},
+		{day(27), 0},
+		{day(18), 2 * time.Hour},
+	}
+
+	for _, tt := range tts {
+		e, err := ts.Expected(tt.day)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, e, tt.day.String())
+	}
+
+	e, err := ts.ExpectedBetween(day(21), day(27))
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Hour, e)
+
+	ts.Calendar = nil
+	e, err = ts.ExpectedBetween(day(21), day(27))
+	assert.NoError(t, err)
+	assert.Equal(t, 36*time.Hour, e)
+}
+
+func TestCalendar(t *testing.T) {
+	c := newCalendar(t, "")
+	ts, err := c.All()
+	assert.NoError(t, err)
+	assert.Empty(t, ts)
+
+	c = newCalendar(t, `
+- date: 2026-12-25
+  name: Christmas
+- date: 2026-01-01
+  name: New Year
+`)
+	ts, err = c.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []worktime.TimeOff{
+		{Date: "2026-01-01", Name: "New Year"},
+		{Date: "2026-12-25", Name: "Christmas"},
+	}, ts)
+
+	c = newCalendar(t, "- date: 25/12/2026\n")
+	_, err = c.All()
+	assert.EqualError(t, err, "read time off file: "+
+		"date \"25/12/2026\" is invalid, use a date like \"2026-12-25\"")
+
+	c = newCalendar(t, "- date: 2026-12-24\n  hours: 4h\n  half: true\n")
+	_, err = c.All()
+	assert.EqualError(t, err, "read time off file: "+
+		"half and hours can't be set on the same day")
+
+	c = newCalendar(t, "- date: 2026-12-24\n  hours: half\n")
+	_, _, err = c.Get(time.Date(2026, 12, 24, 0, 0, 0, 0, time.Local))
+	assert.EqualError(t, err, "read time off file: "+
+		"hours \"half\" is invalid, use a duration like \"4h\"")
+}
+
+func TestCalendar_Save(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "clockify-cli", "time-off.yaml")
+	c := worktime.NewCalendar(p)
+
+	assert.NoError(t, c.Add(
+		worktime.TimeOff{Date: "2026-12-25", Name: "Christmas"},
+		worktime.TimeOff{Date: "2026-12-24", Half: true},
+		worktime.TimeOff{Date: "2026-12-31", Hours: "6h"},
+	))
+	assert.EqualError(t, c.Add(worktime.TimeOff{Date: "2026-12-32"}),
+		"date \"2026-12-32\" is invalid, use a date like \"2026-12-25\"")
+	assert.NoError(t, c.Remove("2026-12-31"))
+	assert.EqualError(t, c.Remove("2026-12-24", "2026-12-30"),
+		"there is no time off on 2026-12-30")
+
+	b, err := os.ReadFile(p)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		- date: "2026-12-24"
+		  half: true
+		- date: "2026-12-25"
+		  name: Christmas
+	`), string(b))
+
+	off, err := worktime.NewCalendar(p).DayOff(
+		time.Date(2026, 12, 24, 10, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.False(t, off)
+
+	off, err = worktime.NewCalendar(p).DayOff(
+		time.Date(2026, 12, 25, 10, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.True(t, off)
+}
+
+func TestParseICS(t *testing.T) {
+	ics := strings.ReplaceAll(heredoc.Doc(`
+		BEGIN:VCALENDAR
+		VERSION:2.0
+		BEGIN:VEVENT
+		DTSTART;VALUE=DATE:20261225
+		DTEND;VALUE=DATE:20261226
+		SUMMARY:Christmas
+		END:VEVENT
+		BEGIN:VEVENT
+		DTSTART:20261231
+		SUMMARY:New Year's Eve\, the last
+		  day of the year
+		END:VEVENT
+		BEGIN:VEVENT
+		DTSTART;VALUE=DATE:20260706
+		DTEND;VALUE=DATE:20260709
+		SUMMARY:Vacation
+		END:VEVENT
+		BEGIN:VEVENT
+		DTSTART:20261224T130000Z
+		DTEND:20261224T180000Z
+		SUMMARY:Party
+		END:VEVENT
+		BEGIN:VEVENT
+		DTSTART;VALUE=DATE:20260101
+		RRULE:FREQ=YEARLY
+		SUMMARY:New Year
+		END:VEVENT
+		END:VCALENDAR
+	`), "\n", "\r\n")
+
+	ts, skipped, err := worktime.ParseICS(strings.NewReader(ics))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, skipped)
+	assert.Equal(t, []worktime.TimeOff{
+		{Date: "2026-12-25", Name: "Christmas"},
+		{Date: "2026-12-31", Name: "New Year's Eve, the last day of the year"},
+		{Date: "2026-07-06", Name: "Vacation"},
+		{Date: "2026-07-07", Name: "Vacation"},
+		{Date: "2026-07-08", Name: "Vacation"},
+	}, ts)
+
+	_, _, err = worktime.ParseICS(strings.NewReader(
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:2026-12-25\nEND:VEVENT\n"))
+	assert.EqualError(t, err,
+		"read ics file: line 3: DTSTART \"2026-12-25\" is invalid")
+}